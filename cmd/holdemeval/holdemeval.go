@@ -3,102 +3,295 @@
 // For example:
 //   holdemeval -hands "AcKh KdTh QhQd" -board 7d8c8sTs
 // The board can be empty (in which case they are preflop equities),
-// or any number of cards up to 5.
+// or any number of cards up to 5. Cards can be written in any form
+// accepted by poker.ParseCard, for example Ah, HA, A♥ or 10h.
+// With -game omaha, it computes Omaha equities instead, with 4-card
+// hands:
+//   holdemeval -game omaha -hands "AcAhKdQd 9s8s7c6c" -board 7d8c2s
+// With -samples or -stderr, holdem equities are estimated by sampling
+// random runouts rather than computed exactly:
+//   holdemeval -hands "AcKh KdTh QhQd 9s9c 5d4d" -samples 100000
+// With -dead, the given cards (for example, folded hands) are removed
+// from the deck, so they can't appear in any runout:
+//   holdemeval -hands "AcKh QhQd" -dead "Ad As 7c2d"
+// For holdem, a player's hand can be given as ?? or xx if it's
+// unknown, or as a range like QQ+,AKs (without spaces) to draw it from
+// that range:
+//   holdemeval -hands "AcKh ?? QQ+,AKs" -board 7d8c2s -samples 100000
+// Exact equities can only be computed with at most one such hand,
+// because every combination of hands from the ranges is evaluated
+// separately.
+// With -categories, it also shows how often each holdem hand ends up
+// as each category of hand (a flush, two pair, and so on), and how
+// often it wins or ties with each category.
+// With -mode outs, it shows each holdem hand's outs on a flop or turn
+// board: the cards that put it ahead, tie it for the lead, or improve
+// it but give the pot to someone else. It also shows which hands lead
+// after each card that could be dealt next:
+//   holdemeval -mode outs -hands "KhQh AsAc" -board Ah7h2c
 package main
 
 import (
 	"flag"
 	"fmt"
+	"math/rand"
 	"os"
+	"runtime"
 	"strings"
 
 	"github.com/paulhankin/poker/v2/poker"
 )
 
 var (
-	handsFlag = flag.String("hands", "", "hands to compare")
-	boardFlag = flag.String("board", "", "board cards to start with")
+	modeFlag    = flag.String("mode", "equity", "what to compute: equity, or outs on a flop or turn (holdem only)")
+	handsFlag   = flag.String("hands", "", "hands to compare")
+	boardFlag   = flag.String("board", "", "board cards to start with")
+	deadFlag    = flag.String("dead", "", "dead cards that can't appear in runouts (holdem only)")
+	gameFlag    = flag.String("game", "holdem", "the game to evaluate: holdem or omaha")
+	workersFlag = flag.Int("workers", runtime.NumCPU(), "number of goroutines to use for holdem equities")
+	samplesFlag = flag.Int("samples", 0, "if non-zero, estimate holdem equities by sampling at most this many runouts")
+	stdErrFlag  = flag.Float64("stderr", 0, "if non-zero, estimate holdem equities by sampling runouts until the standard error is at most this")
+	seedFlag    = flag.Int64("seed", 1, "random seed to use when sampling runouts")
+	catsFlag    = flag.Bool("categories", false, "show how often each holdem hand ends up in each category")
 )
 
-func parseCard(s string) (poker.Card, error) {
-	if len(s) != 2 {
-		return 0, fmt.Errorf("card should be of length 2, like Ac, but got %q", s)
-	}
-	c, ok := poker.NameToCard[strings.ToUpper(s)]
-	if ok {
-		return c, nil
+// isUnknownHand reports whether s is a placeholder for an unknown hand.
+func isUnknownHand(s string) bool {
+	return s == "??" || strings.EqualFold(s, "xx")
+}
+
+// printCategories prints how often a hand ends up in each category,
+// out of the given number of runouts.
+func printCategories(cc *poker.CategoryCounts, boards int) {
+	pc := func(n int) float64 {
+		return float64(n) * 100 / float64(boards)
 	}
-	// try with suit and rank the other way round
-	c, ok = poker.NameToCard[strings.ToUpper(s[1:]+s[:1])]
-	if ok {
-		return c, nil
+	for c := poker.FiveOfAKind; c >= poker.HighCard; c-- {
+		if cc.Hands[c] == 0 {
+			continue
+		}
+		fmt.Printf("\t%s: %.02f%%\twin:%.02f%%\ttie:%.02f%%\n", c, pc(cc.Hands[c]), pc(cc.Wins[c]), pc(cc.Ties[c]))
 	}
-	return 0, fmt.Errorf("failed to parse card %q", s)
 }
 
-func parseHand(s string) ([2]poker.Card, error) {
-	var hz [2]poker.Card
-	if len(s) != 4 {
-		return hz, fmt.Errorf("expect hand in format like AcKh, got %q", s)
+// cardsString returns the cards, rank first, each preceded by a space.
+func cardsString(cs []poker.Card) string {
+	var r string
+	for _, c := range cs {
+		r += " " + c.RankFirst()
 	}
-	c0, err0 := parseCard(s[:2])
-	c1, err1 := parseCard(s[2:])
-	if err0 == nil {
-		err0 = err1
+	return r
+}
+
+// printOuts prints the outs of the named hands, and the hands that
+// lead after each card.
+func printOuts(names []string, r *poker.OutsResult) {
+	for i, o := range r.Outs {
+		state := "behind"
+		for _, l := range r.Leaders {
+			if l == i && len(r.Leaders) == 1 {
+				state = "ahead"
+			} else if l == i {
+				state = "tied"
+			}
+		}
+		fmt.Printf("%s: %s\n", names[i], state)
+		if state == "ahead" {
+			continue
+		}
+		fmt.Printf("\tclean outs (%d):%s\n", len(o.Clean), cardsString(o.Clean))
+		fmt.Printf("\tsplit outs (%d):%s\n", len(o.Split), cardsString(o.Split))
+		fmt.Printf("\tdirty outs (%d):%s\n", len(o.Dirty), cardsString(o.Dirty))
 	}
-	if err0 != nil {
-		return hz, err0
+	fmt.Printf("next card:\n")
+	for _, co := range r.Cards {
+		var ls []string
+		for _, l := range co.Leaders {
+			ls = append(ls, names[l])
+		}
+		if len(ls) > 1 {
+			fmt.Printf("\t%s: split %s\n", co.Card.RankFirst(), strings.Join(ls, " "))
+		} else {
+			fmt.Printf("\t%s: %s\n", co.Card.RankFirst(), ls[0])
+		}
 	}
-	return [2]poker.Card{c0, c1}, nil
 }
 
-func fmtHand(h [2]poker.Card) string {
-	s0 := h[0].Rank().String() + strings.ToLower(h[0].Suit().String())
-	s1 := h[1].Rank().String() + strings.ToLower(h[1].Suit().String())
-	return s0 + s1
+// parseHand parses a hand of n cards.
+func parseHand(s string, n int) (poker.Hand, error) {
+	h, err := poker.ParseHand(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(h) != n {
+		return nil, fmt.Errorf("expect hand of %d cards in format like AcKh, got %q", n, s)
+	}
+	return h, nil
 }
 
 func main() {
 	flag.Parse()
-	var hands [][2]poker.Card
+	var hands []poker.Hand
 
 	fail := func(err error) {
 		fmt.Fprintf(os.Stderr, "error: %s", err)
 		os.Exit(1)
 	}
 
-	if len(*handsFlag) == 0 {
-		fail(fmt.Errorf("must specify one or more hands via the -hands flag"))
+	var handSize int
+	switch *gameFlag {
+	case "holdem":
+		handSize = 2
+	case "omaha":
+		handSize = 4
+	default:
+		fail(fmt.Errorf("unknown game %q: must be holdem or omaha", *gameFlag))
 	}
 
+	// For holdem, ranges[i] is the range of hands of player i, and
+	// ranged is the number of players whose hands aren't known.
+	var ranges []poker.Range
+	var names []string
+	ranged := 0
 	for _, p := range strings.Fields(*handsFlag) {
-		h, err := parseHand(p)
+		if *gameFlag == "holdem" && isUnknownHand(p) {
+			ranges = append(ranges, poker.FullRange())
+			names = append(names, p)
+			ranged++
+			continue
+		}
+		h, err := parseHand(p, handSize)
 		if err != nil {
-			fail(err)
+			r, rerr := poker.ParseRange(p)
+			if *gameFlag != "holdem" || rerr != nil || len(r) == 0 {
+				fail(err)
+			}
+			ranges = append(ranges, r)
+			names = append(names, p)
+			ranged++
+			continue
 		}
 		hands = append(hands, h)
+		names = append(names, h.RankFirst())
+		if handSize == 2 {
+			ranges = append(ranges, poker.HandRange([2]poker.Card{h[0], h[1]}))
+		}
 	}
 
-	brd := strings.ReplaceAll(*boardFlag, " ", "")
-	if len(brd)%2 != 0 {
-		fail(fmt.Errorf("bad -board flag %q. Missing a suit or rank?", *boardFlag))
+	if len(names) == 0 {
+		fail(fmt.Errorf("must specify one or more hands via the -hands flag"))
 	}
-	var board []poker.Card
-	for i := 0; i < len(brd); i += 2 {
-		c, err := parseCard(brd[i : i+2])
+
+	board, err := poker.ParseBoard(*boardFlag)
+	if err != nil {
+		fail(fmt.Errorf("bad -board flag %q: %v", *boardFlag, err))
+	}
+
+	dead, err := poker.ParseHand(*deadFlag)
+	if err != nil {
+		fail(fmt.Errorf("bad -dead flag %q: %v", *deadFlag, err))
+	}
+	if len(dead) > 0 && *gameFlag != "holdem" {
+		fail(fmt.Errorf("dead cards are only supported for holdem"))
+	}
+	if *catsFlag && *gameFlag != "holdem" {
+		fail(fmt.Errorf("categories are only supported for holdem"))
+	}
+
+	switch *modeFlag {
+	case "equity":
+	case "outs":
+		if *gameFlag != "holdem" || ranged > 0 {
+			fail(fmt.Errorf("outs are only supported for holdem, with every hand known"))
+		}
+		hhands := make([][2]poker.Card, len(hands))
+		for i, h := range hands {
+			copy(hhands[i][:], h)
+		}
+		r, err := poker.HoldemOuts(hhands, board, dead)
 		if err != nil {
-			fail(fmt.Errorf("bad -board flag %q: %v", *boardFlag, err))
+			fail(fmt.Errorf("failed to compute outs: %v", err))
 		}
-		board = append(board, c)
+		printOuts(names, r)
+		return
+	default:
+		fail(fmt.Errorf("unknown mode %q: must be equity or outs", *modeFlag))
 	}
 
-	eqs, err := poker.HoldemEquities(hands, board)
+	var eqs []poker.Equity
+	var ests []poker.EquityEstimate
+	sampled := *samplesFlag != 0 || *stdErrFlag != 0
+	if sampled && *gameFlag != "holdem" {
+		fail(fmt.Errorf("sampling is only supported for holdem"))
+	}
+	if !sampled && ranged > 1 {
+		// Every combination of hands from the ranges is evaluated
+		// separately, which takes hours preflop.
+		fail(fmt.Errorf("exact equities with more than one unknown hand or range are too slow: use -samples or -stderr to estimate them"))
+	}
+	if sampled && ranged > 0 {
+		ests, err = poker.RangeEquitiesSampled(ranges, board, poker.SampleOptions{
+			Samples:      *samplesFlag,
+			TargetStdErr: *stdErrFlag,
+			Rand:         rand.New(rand.NewSource(*seedFlag)),
+			Dead:         dead,
+			Categories:   *catsFlag,
+		})
+	} else if sampled {
+		hhands := make([][2]poker.Card, len(hands))
+		for i, h := range hands {
+			copy(hhands[i][:], h)
+		}
+		ests, err = poker.HoldemEquitiesSampled(hhands, board, poker.SampleOptions{
+			Samples:      *samplesFlag,
+			TargetStdErr: *stdErrFlag,
+			Rand:         rand.New(rand.NewSource(*seedFlag)),
+			Dead:         dead,
+			Categories:   *catsFlag,
+		})
+	} else if *gameFlag == "omaha" {
+		ohands := make([][4]poker.Card, len(hands))
+		for i, h := range hands {
+			copy(ohands[i][:], h)
+		}
+		eqs, err = poker.OmahaEquities(ohands, board)
+	} else if ranged > 0 {
+		eqs, err = poker.RangeEquitiesWithOptions(ranges, board, poker.EquityOptions{
+			Workers:    *workersFlag,
+			Dead:       dead,
+			Categories: *catsFlag,
+		})
+	} else {
+		hhands := make([][2]poker.Card, len(hands))
+		for i, h := range hands {
+			copy(hhands[i][:], h)
+		}
+		eqs, err = poker.HoldemEquitiesWithOptions(hhands, board, poker.EquityOptions{
+			Workers:    *workersFlag,
+			Dead:       dead,
+			Categories: *catsFlag,
+		})
+	}
 	if err != nil {
 		fail(fmt.Errorf("failed to compute equities: %v", err))
 	}
+	if sampled {
+		fmt.Printf("%d runouts sampled\n", ests[0].Boards)
+		for i := range names {
+			lo, hi := ests[i].Interval95()
+			fmt.Printf("%s: equity:%.02f%% (95%%: %.02f%%-%.02f%%)\twin:%.02f%%\ttie:%.02f%%\n", names[i], ests[i].Equity.Equity*100, lo*100, hi*100, ests[i].Win*100, ests[i].Tie*100)
+			if ests[i].Categories != nil {
+				printCategories(ests[i].Categories, ests[i].Boards)
+			}
+		}
+		return
+	}
 	fmt.Printf("%d runouts evaluated\n", eqs[0].Boards)
-	for i := 0; i < len(hands); i++ {
-		fmt.Printf("%s: equity:%.02f%%\twin:%.02f%%\ttie:%.02f%%\n", fmtHand(hands[i]), eqs[i].Equity*100, eqs[i].Win*100, eqs[i].Tie*100)
+	for i := range names {
+		fmt.Printf("%s: equity:%.02f%%\twin:%.02f%%\ttie:%.02f%%\n", names[i], eqs[i].Equity*100, eqs[i].Win*100, eqs[i].Tie*100)
+		if eqs[i].Categories != nil {
+			printCategories(eqs[i].Categories, eqs[i].Boards)
+		}
 	}
 
 }
@@ -0,0 +1,121 @@
+package poker
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// A CardSet is a set of cards, represented as a bitmask where
+// bit c is set if card c is in the set. The zero value is the empty
+// set. Only valid cards can be stored in a CardSet.
+type CardSet uint64
+
+// FullCardSet is the set of all 52 cards.
+const FullCardSet = CardSet(1)<<52 - 1
+
+// MakeCardSet returns the set of the given cards. It returns an error
+// if any card is invalid or appears more than once.
+func MakeCardSet(cards ...Card) (CardSet, error) {
+	var s CardSet
+	for i, c := range cards {
+		if !c.Valid() {
+			return 0, fmt.Errorf("card %d is invalid: %d", i, c)
+		}
+		if s.Contains(c) {
+			return 0, fmt.Errorf("duplicate card %s", c)
+		}
+		s = s.Add(c)
+	}
+	return s, nil
+}
+
+// Add returns the set with c added. The card must be valid.
+func (s CardSet) Add(c Card) CardSet {
+	return s | 1<<c
+}
+
+// Remove returns the set with c removed.
+func (s CardSet) Remove(c Card) CardSet {
+	return s &^ (1 << c)
+}
+
+// Contains reports whether c is in the set.
+func (s CardSet) Contains(c Card) bool {
+	return c.Valid() && s&(1<<c) != 0
+}
+
+// Union returns the set of cards in either s or t.
+func (s CardSet) Union(t CardSet) CardSet {
+	return s | t
+}
+
+// Intersect returns the set of cards in both s and t.
+func (s CardSet) Intersect(t CardSet) CardSet {
+	return s & t
+}
+
+// Count returns the number of cards in the set.
+func (s CardSet) Count() int {
+	return bits.OnesCount64(uint64(s))
+}
+
+// ForEach calls f for each card in the set, in increasing order.
+func (s CardSet) ForEach(f func(c Card)) {
+	for s != 0 {
+		f(Card(bits.TrailingZeros64(uint64(s))))
+		s &= s - 1
+	}
+}
+
+// Hand returns the cards in the set, in increasing order.
+func (s CardSet) Hand() Hand {
+	h := make(Hand, 0, s.Count())
+	s.ForEach(func(c Card) {
+		h = append(h, c)
+	})
+	return h
+}
+
+// String returns the string form of the cards in the set.
+func (s CardSet) String() string {
+	return s.Hand().String()
+}
+
+// CardSet returns the set of cards in the hand. It returns an error
+// if any card is invalid or appears more than once.
+func (h Hand) CardSet() (CardSet, error) {
+	return MakeCardSet(h...)
+}
+
+// EvalSet evaluates the best 5-card poker hand in a set of 5, 6 or 7
+// cards, returning a rank for the hand from 0 to ScoreMax (inclusive).
+// The ranks are comparable with those returned by Eval5 and Eval7.
+// If the set has fewer than 5 or more than 7 cards, it returns -1.
+func EvalSet(s CardSet) int16 {
+	var h [7]Card
+	n := 0
+	for t := s; t != 0 && n < 7; t &= t - 1 {
+		h[n] = Card(bits.TrailingZeros64(uint64(t)))
+		n++
+	}
+	switch s.Count() {
+	case 5:
+		var h5 [5]Card
+		copy(h5[:], h[:])
+		return Eval5(&h5)
+	case 6:
+		best := int16(-1)
+		for i := 0; i < 6; i++ {
+			var h5 [5]Card
+			copy(h5[:], h[:i])
+			copy(h5[i:], h[i+1:6])
+			if ev := Eval5(&h5); ev > best {
+				best = ev
+			}
+		}
+		return best
+	case 7:
+		return Eval7(&h)
+	}
+	return -1
+}
@@ -0,0 +1,101 @@
+package poker
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestCardSet(t *testing.T) {
+	h := parseHands(t, "SA HK D2 C7")[0]
+	s, err := MakeCardSet(h...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Count() != 4 {
+		t.Errorf("%v has %d cards, want 4", s, s.Count())
+	}
+	for _, c := range Cards {
+		want := false
+		for _, hc := range h {
+			want = want || hc == c
+		}
+		if got := s.Contains(c); got != want {
+			t.Errorf("%v.Contains(%s) = %v, want %v", s, c, got, want)
+		}
+	}
+	sa, c7 := NameToCard["SA"], NameToCard["C7"]
+	if got := s.Remove(sa).Remove(sa); got.Count() != 3 || got.Contains(sa) {
+		t.Errorf("%v with SA removed = %v", s, got)
+	}
+	if got := s.Add(c7); got != s {
+		t.Errorf("adding C7 to %v gave %v", s, got)
+	}
+	t2, _ := MakeCardSet(parseHands(t, "SA CK C7")[0]...)
+	if got := s.Intersect(t2).Hand(); !reflect.DeepEqual(got, Hand{c7, sa}) && !reflect.DeepEqual(got, Hand{sa, c7}) {
+		t.Errorf("%v intersect %v = %v", s, t2, got)
+	}
+	if got := s.Union(t2).Count(); got != 5 {
+		t.Errorf("%v union %v has %d cards, want 5", s, t2, got)
+	}
+	if FullCardSet.Count() != 52 {
+		t.Errorf("full set has %d cards", FullCardSet.Count())
+	}
+
+	back, err := s.Hand().CardSet()
+	if err != nil || back != s {
+		t.Errorf("%v round-tripped via Hand to %v (err %v)", s, back, err)
+	}
+	if _, err := MakeCardSet(sa, c7, sa); err == nil {
+		t.Errorf("expected error for duplicate cards")
+	}
+	if _, err := MakeCardSet(sa, 52); err == nil {
+		t.Errorf("expected error for invalid card")
+	}
+}
+
+func TestEvalSet(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	for i := 0; i < 10000; i++ {
+		n := 5 + i%3
+		perm := rnd.Perm(52)
+		var h Hand
+		for _, p := range perm[:n] {
+			h = append(h, Cards[p])
+		}
+		s, err := h.CardSet()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var want int16
+		switch n {
+		case 5, 7:
+			want = EvalSlow(h)
+		case 6:
+			// The best of the six 5-card hands.
+			want = -1
+			for j := 0; j < 6; j++ {
+				h5 := append(append(Hand{}, h[:j]...), h[j+1:]...)
+				if ev := EvalSlow(h5); ev > want {
+					want = ev
+				}
+			}
+		}
+		if got := EvalSet(s); got != want {
+			t.Errorf("EvalSet(%v) = %d, want %d", s, got, want)
+		}
+	}
+	if got := EvalSet(FullCardSet); got != -1 {
+		t.Errorf("EvalSet of 52 cards = %d, want -1", got)
+	}
+}
+
+func BenchmarkEvalSet(b *testing.B) {
+	s, err := MakeCardSet(parseHands(b, "SA HK D2 C7 C8 CT H9")[0]...)
+	if err != nil {
+		b.Fatal(err)
+	}
+	for i := 0; i < b.N; i++ {
+		EvalSet(s)
+	}
+}
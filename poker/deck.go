@@ -0,0 +1,99 @@
+package poker
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// A Deck is a deck of cards that can be shuffled and dealt from.
+// Cards are dealt from the top of the deck, which is the start of
+// the slice returned by Remaining.
+type Deck struct {
+	cards []Card
+	set   CardSet // the cards in the deck
+}
+
+// NewDeck returns a full, unshuffled deck of 52 cards, in the same
+// order as Cards.
+func NewDeck() *Deck {
+	d, _ := NewDeckOf(Cards)
+	return d
+}
+
+// NewDeckOf returns an unshuffled deck of the given cards, for example
+// ShortDeckCards. The cards must be valid and distinct.
+func NewDeckOf(cards []Card) (*Deck, error) {
+	set, err := MakeCardSet(cards...)
+	if err != nil {
+		return nil, err
+	}
+	return &Deck{cards: append([]Card(nil), cards...), set: set}, nil
+}
+
+// Shuffle randomly shuffles the cards remaining in the deck, using
+// the given source of randomness. Using a generator with a fixed seed
+// gives a reproducible shuffle. If rnd is nil, the default source in
+// math/rand is used.
+func (d *Deck) Shuffle(rnd *rand.Rand) {
+	swap := func(i, j int) {
+		d.cards[i], d.cards[j] = d.cards[j], d.cards[i]
+	}
+	if rnd == nil {
+		rand.Shuffle(len(d.cards), swap)
+		return
+	}
+	rnd.Shuffle(len(d.cards), swap)
+}
+
+// Deal removes n cards from the top of the deck and returns them.
+// It returns an error if there are fewer than n cards remaining.
+func (d *Deck) Deal(n int) (Hand, error) {
+	if n < 0 || n > len(d.cards) {
+		return nil, fmt.Errorf("can't deal %d cards from a deck of %d cards", n, len(d.cards))
+	}
+	h := append(Hand(nil), d.cards[:n]...)
+	for _, c := range h {
+		d.set = d.set.Remove(c)
+	}
+	d.cards = d.cards[n:]
+	return h, nil
+}
+
+// Remove removes dead cards from the deck, for example cards known to
+// be in other players' hands. The order of the remaining cards is
+// unchanged. It returns an error, and removes nothing, if any of
+// the cards aren't in the deck.
+func (d *Deck) Remove(dead ...Card) error {
+	var ds CardSet
+	for _, c := range dead {
+		if !d.set.Contains(c) || ds.Contains(c) {
+			return fmt.Errorf("card %s is not in the deck", c)
+		}
+		ds = ds.Add(c)
+	}
+	cards := d.cards[:0]
+	for _, c := range d.cards {
+		if !ds.Contains(c) {
+			cards = append(cards, c)
+		}
+	}
+	d.cards = cards
+	d.set = d.set.Intersect(^ds)
+	return nil
+}
+
+// Contains reports whether the card is still in the deck.
+func (d *Deck) Contains(c Card) bool {
+	return d.set.Contains(c)
+}
+
+// Len returns the number of cards remaining in the deck.
+func (d *Deck) Len() int {
+	return len(d.cards)
+}
+
+// Remaining returns a copy of the cards remaining in the deck, with
+// the top of the deck first.
+func (d *Deck) Remaining() Hand {
+	return append(Hand(nil), d.cards...)
+}
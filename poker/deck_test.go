@@ -0,0 +1,82 @@
+package poker
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestDeck(t *testing.T) {
+	d := NewDeck()
+	if !reflect.DeepEqual(d.Remaining(), Hand(Cards)) {
+		t.Errorf("new deck is %v, want %v", d.Remaining(), Hand(Cards))
+	}
+	dead := parseHands(t, "HA DK")[0]
+	if err := d.Remove(dead...); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Remove(dead[0]); err == nil {
+		t.Errorf("expected error removing %s twice", dead[0])
+	}
+	if d.Len() != 50 || d.Contains(dead[0]) || d.Contains(dead[1]) {
+		t.Errorf("after removing %v, deck has %d cards: %v", dead, d.Len(), d.Remaining())
+	}
+
+	d.Shuffle(rand.New(rand.NewSource(42)))
+	h, err := d.Deal(5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(h) != 5 || d.Len() != 45 {
+		t.Errorf("dealt %v, leaving %d cards", h, d.Len())
+	}
+	seen := CardSet(0)
+	for _, c := range append(d.Remaining(), h...) {
+		if seen.Contains(c) {
+			t.Errorf("card %s is in the deck twice", c)
+		}
+		seen = seen.Add(c)
+	}
+	for _, c := range append(h, dead...) {
+		if d.Contains(c) {
+			t.Errorf("deck still contains %s", c)
+		}
+	}
+	if _, err := d.Deal(46); err == nil {
+		t.Errorf("expected error dealing 46 cards from %d", d.Len())
+	}
+	if err := d.Remove(h[0]); err == nil {
+		t.Errorf("expected error removing dealt card %s", h[0])
+	}
+}
+
+func TestDeckShuffleSeed(t *testing.T) {
+	deal := func(seed int64) Hand {
+		d := NewDeck()
+		d.Shuffle(rand.New(rand.NewSource(seed)))
+		h, err := d.Deal(9)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return h
+	}
+	if a, b := deal(1), deal(1); !reflect.DeepEqual(a, b) {
+		t.Errorf("same seed dealt %v and %v", a, b)
+	}
+	if a, b := deal(1), deal(2); reflect.DeepEqual(a, b) {
+		t.Errorf("different seeds both dealt %v", a)
+	}
+}
+
+func TestNewDeckOf(t *testing.T) {
+	d, err := NewDeckOf(ShortDeckCards)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Len() != 36 || d.Contains(NameToCard["S5"]) {
+		t.Errorf("short deck has %d cards: %v", d.Len(), d.Remaining())
+	}
+	if _, err := NewDeckOf(parseHands(t, "HA HA")[0]); err == nil {
+		t.Errorf("expected error for deck with duplicate cards")
+	}
+}
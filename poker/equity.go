@@ -1,17 +1,31 @@
 package poker
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 )
 
 // Equity contains information about poker hand equity.
 type Equity struct {
-	Equity float64 // total equity in the pot
-	Win    float64 // equity gained from outright winning the pot
-	Tie    float64 // probability of tieing with 1 or more hands
-	Boards int     // how many runouts were computed
+	Equity float64 `json:"equity"` // total equity in the pot
+	Win    float64 `json:"win"`    // equity gained from outright winning the pot
+	Tie    float64 `json:"tie"`    // probability of tieing with 1 or more hands
+	Boards int     `json:"boards"` // how many runouts were computed
+
+	// Categories, if requested in the options, counts the runouts by
+	// the category of the hand's best five cards.
+	Categories *CategoryCounts `json:"categories,omitempty"`
+}
+
+// CategoryCounts counts the runouts on which a hand ends up in each
+// category, and the runouts it wins or ties with each category.
+type CategoryCounts struct {
+	Hands [FiveOfAKind + 1]int `json:"hands"` // runouts on which the hand is in each category
+	Wins  [FiveOfAKind + 1]int `json:"wins"`  // runouts won outright with each category
+	Ties  [FiveOfAKind + 1]int `json:"ties"`  // runouts tied with each category
 }
 
 func boardString(b []Card) string {
@@ -22,13 +36,54 @@ func boardString(b []Card) string {
 	return "[" + strings.Join(parts, " ") + "]"
 }
 
-func holdemRiverEquities(hbs [][7]Card, evs []int16, eqs []Equity) {
-	H := len(hbs)
+func holdemRiverEquities(hbs [][7]Card, evs []int16, ec *equityCounts) {
+	for i := range hbs {
+		evs[i] = Eval7(&hbs[i])
+	}
+	ec.add(evs)
+}
+
+// equityCounts accumulates how often each hand wins or shares the pot.
+// The totals are kept as integers so that they don't depend on the
+// order that runouts are considered in. That means runouts can be
+// split between goroutines without changing the results.
+type equityCounts struct {
+	H int
+	// shares[i*(H+1)+k] is the number of runouts on which hand i
+	// won a 1/k share of the pot.
+	shares []int64
+	// cats, if not nil, counts the runouts by the category of each
+	// hand. The evaluations must be from Eval7.
+	cats []CategoryCounts
+}
+
+func newEquityCounts(H int) *equityCounts {
+	return &equityCounts{H: H, shares: make([]int64, H*(H+1))}
+}
+
+// trackCategories makes ec count runouts by the category of each
+// hand, if on is true.
+func (ec *equityCounts) trackCategories(on bool) *equityCounts {
+	if on {
+		ec.cats = make([]CategoryCounts, ec.H)
+	}
+	return ec
+}
+
+// add records the result of a single runout, given the evaluations
+// of the hands.
+func (ec *equityCounts) add(evs []int16) {
+	ec.addN(evs, 1)
+}
+
+// addN records the result of n runouts which all have the same
+// evaluations of the hands.
+func (ec *equityCounts) addN(evs []int16, n int64) {
+	H := len(evs)
 	winCount := 0
 	var bestEV int16 = -1000
 	for i := 0; i < H; i++ {
-		ev := Eval7(&hbs[i])
-		evs[i] = ev
+		ev := evs[i]
 		if ev > bestEV {
 			winCount = 1
 			bestEV = ev
@@ -36,48 +91,114 @@ func holdemRiverEquities(hbs [][7]Card, evs []int16, eqs []Equity) {
 			winCount++
 		}
 	}
-
-	v := 1.0 / float64(winCount)
 	for i := 0; i < H; i++ {
-		if evs[i] != bestEV {
-			continue
+		if evs[i] == bestEV {
+			ec.shares[i*(H+1)+winCount] += n
 		}
-		eqs[i].Equity += v
-		if winCount == 1 {
-			eqs[i].Win += v
-		} else {
-			eqs[i].Tie += 1.0
+	}
+	if ec.cats == nil {
+		return
+	}
+	for i := 0; i < H; i++ {
+		c := scoreCategory(evs[i])
+		ec.cats[i].Hands[c] += int(n)
+		if evs[i] == bestEV && winCount == 1 {
+			ec.cats[i].Wins[c] += int(n)
+		} else if evs[i] == bestEV {
+			ec.cats[i].Ties[c] += int(n)
 		}
 	}
 }
 
+// merge adds the counts from o into ec.
+func (ec *equityCounts) merge(o *equityCounts) {
+	for i, n := range o.shares {
+		ec.shares[i] += n
+	}
+	for i := range o.cats {
+		ec.cats[i].add(&o.cats[i])
+	}
+}
+
+// add adds the counts from o into cc.
+func (cc *CategoryCounts) add(o *CategoryCounts) {
+	for c := range cc.Hands {
+		cc.Hands[c] += o.Hands[c]
+		cc.Wins[c] += o.Wins[c]
+		cc.Ties[c] += o.Ties[c]
+	}
+}
+
+// equities converts the counts accumulated over T runouts into
+// equities.
+func (ec *equityCounts) equities(T int) []Equity {
+	eqs := make([]Equity, ec.H)
+	if T == 0 {
+		return eqs
+	}
+	for i := range eqs {
+		var eq, tie float64
+		for k := 1; k <= ec.H; k++ {
+			n := float64(ec.shares[i*(ec.H+1)+k])
+			eq += n / float64(k)
+			if k > 1 {
+				tie += n
+			}
+		}
+		eqs[i] = Equity{
+			Equity: eq / float64(T),
+			Win:    float64(ec.shares[i*(ec.H+1)+1]) / float64(T),
+			Tie:    tie / float64(T),
+			Boards: T,
+		}
+		if ec.cats != nil {
+			cc := ec.cats[i]
+			eqs[i].Categories = &cc
+		}
+	}
+	return eqs
+}
+
 func getRemainingDeck(hands [][2]Card, board []Card) ([]Card, error) {
-	got := map[Card]int{}
+	hs := make([]Hand, len(hands))
+	for i := range hands {
+		hs[i] = hands[i][:]
+	}
+	return remainingDeck(hs, board)
+}
+
+// remainingDeck returns the cards that aren't in any of the hands
+// or the board, after checking that the hands and board are valid
+// and distinct.
+func remainingDeck(hands []Hand, board []Card) ([]Card, error) {
+	var got, dups CardSet
 	for i, h := range hands {
-		if !h[0].Valid() {
-			return nil, fmt.Errorf("hand %d contains invalid first card %d", i, h[0])
-		}
-		if !h[1].Valid() {
-			return nil, fmt.Errorf("hand %d contains invalid second card %d", i, h[1])
+		for j, c := range h {
+			if !c.Valid() {
+				return nil, fmt.Errorf("hand %d contains invalid card %d at position %d", i, c, j)
+			}
+			if got.Contains(c) {
+				dups = dups.Add(c)
+			}
+			got = got.Add(c)
 		}
-		got[h[0]]++
-		got[h[1]]++
 	}
 	for i, b := range board {
 		if !b.Valid() {
 			return nil, fmt.Errorf("board[%d] card is invalid: %d", i, b)
 		}
-		got[b]++
-	}
-	if len(got) != 2*len(hands)+len(board) {
-		var dups []string
-		for c, i := range got {
-			if i > 1 {
-				dups = append(dups, c.String())
-			}
+		if got.Contains(b) {
+			dups = dups.Add(b)
 		}
-		sort.Strings(dups)
-		return nil, fmt.Errorf("duplicate cards: %v found", dups)
+		got = got.Add(b)
+	}
+	if dups != 0 {
+		var ds []string
+		dups.ForEach(func(c Card) {
+			ds = append(ds, c.String())
+		})
+		sort.Strings(ds)
+		return nil, fmt.Errorf("duplicate cards: %v found", ds)
 	}
 	if len(board) > 5 {
 		return nil, fmt.Errorf("board %s has more than 5 (%d) cards", boardString(board), len(board))
@@ -86,23 +207,117 @@ func getRemainingDeck(hands [][2]Card, board []Card) ([]Card, error) {
 	// deck is all the cards that aren't already in a hand or board.
 	var deck []Card
 	for _, c := range Cards {
-		if got[c] > 0 {
-			continue
+		if !got.Contains(c) {
+			deck = append(deck, c)
 		}
-		deck = append(deck, c)
 	}
 	return deck, nil
 }
 
+// removeDeadCards returns the deck without the dead cards, after
+// checking that the dead cards are valid and distinct, and are still
+// in the deck rather than in a hand or on the board. It also checks
+// that at least need cards are left, to be dealt.
+func removeDeadCards(deck []Card, dead []Card, need int) ([]Card, error) {
+	if len(dead) == 0 {
+		return deck, nil
+	}
+	inDeck, _ := MakeCardSet(deck...)
+	var ds CardSet
+	for i, c := range dead {
+		if !c.Valid() {
+			return nil, fmt.Errorf("dead card %d is invalid: %d", i, c)
+		}
+		if ds.Contains(c) {
+			return nil, fmt.Errorf("dead card %s is given more than once", c)
+		}
+		if !inDeck.Contains(c) {
+			return nil, fmt.Errorf("dead card %s is in a hand or on the board", c)
+		}
+		ds = ds.Add(c)
+	}
+	var r []Card
+	for _, c := range deck {
+		if !ds.Contains(c) {
+			r = append(r, c)
+		}
+	}
+	if len(r) < need {
+		return nil, fmt.Errorf("only %d cards are left after removing the dead cards, but %d are needed", len(r), need)
+	}
+	return r, nil
+}
+
+// EquityOptions holds optional settings for computing equities.
+// The zero value computes equities in the same way as HoldemEquities.
+type EquityOptions struct {
+	// Workers is the number of goroutines that runouts are
+	// split between. Values less than 2 mean runouts are enumerated
+	// on the calling goroutine. The results are identical however
+	// many workers are used.
+	Workers int
+
+	// Progress, if not nil, is called after each batch of runouts
+	// is evaluated, with the number of runouts evaluated so far and
+	// the total number of runouts. It's called on the goroutine that
+	// is computing the equities, and never concurrently.
+	Progress func(done, total int)
+
+	// Dead is cards known to be out of play, for example folded or
+	// exposed cards, which can't appear in any runout. They mustn't
+	// be in any of the hands or on the board.
+	Dead []Card
+
+	// Categories, if true, causes each hand's equity to include
+	// counts of the runouts by the hand's final category.
+	Categories bool
+}
+
 // HoldemEquities returns the river equities for the given holdem hands
 // given a board of up to 5 cards.
 // The hands and board must be distinct, and the board can't have more
 // than 5 cards in it.
 func HoldemEquities(hands [][2]Card, board []Card) ([]Equity, error) {
+	return HoldemEquitiesWithOptions(hands, board, EquityOptions{})
+}
+
+// HoldemEquitiesWithOptions is like HoldemEquities, but allows the
+// calculation to be configured.
+func HoldemEquitiesWithOptions(hands [][2]Card, board []Card, opts EquityOptions) ([]Equity, error) {
+	return HoldemEquitiesContext(context.Background(), hands, board, opts)
+}
+
+// binomial returns n choose k.
+func binomial(n, k int) int {
+	if k < 0 || k > n {
+		return 0
+	}
+	r := 1
+	for i := 0; i < k; i++ {
+		r = r * (n - i) / (i + 1)
+	}
+	return r
+}
+
+// HoldemEquitiesContext is like HoldemEquitiesWithOptions, but stops
+// early if the context is cancelled. In that case, it returns the
+// equities over the runouts evaluated so far (which may be none, in
+// which case the equities are all zero), and the context's error.
+func HoldemEquitiesContext(ctx context.Context, hands [][2]Card, board []Card, opts EquityOptions) ([]Equity, error) {
 	deck, err := getRemainingDeck(hands, board)
 	if err != nil {
 		return nil, err
 	}
+	if deck, err = removeDeadCards(deck, opts.Dead, 5-len(board)); err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return newEquityCounts(len(hands)).trackCategories(opts.Categories).equities(0), err
+	}
+	progress := opts.Progress
+	if progress == nil {
+		progress = func(done, total int) {}
+	}
 
 	// hbs is hands and board.
 	// We store the fixed cards (given hand and board) at the
@@ -117,46 +332,89 @@ func HoldemEquities(hands [][2]Card, board []Card) ([]Equity, error) {
 		}
 	}
 
-	eqs := make([]Equity, len(hands))
-	evs := make([]int16, len(hands))
-
+	ec := newEquityCounts(len(hands)).trackCategories(opts.Categories)
 	if len(board) == 5 {
-		holdemRiverEquities(hbs, evs, eqs)
-		for i := range eqs {
-			eqs[i].Boards = 1
-		}
-		return eqs, nil
+		holdemRiverEquities(hbs, make([]int16, len(hands)), ec)
+		progress(1, 1)
+		return ec.equities(1), nil
 	}
 
-	idxs := make([]int, 5-len(board))
-	for i := range idxs {
-		idxs[i] = i
+	// Runouts are grouped into classes that are equivalent given
+	// the hands, and split into units of work by the ranks of the
+	// new cards.
+	k := 5 - len(board)
+	total := binomial(len(deck), k)
+	ir := newIsoRunouts(deck, board)
+	units := ir.rankMultisets()
+	workers := opts.Workers
+	if workers > len(units) {
+		workers = len(units)
+	}
+	if workers < 2 {
+		T := 0
+		evs := make([]int16, len(hands))
+		for _, ranks := range units {
+			if err := ctx.Err(); err != nil {
+				return ec.equities(T), err
+			}
+			if n := ir.runouts(ranks, hbs, evs, ec); n > 0 {
+				T += n
+				progress(T, total)
+			}
+		}
+		return ec.equities(T), nil
 	}
 
-	H := len(hands)
-	T := 0 // total number of runouts we've considered.
-
-	for {
-		T++
-		// update the boards
-		for j, ix := range idxs {
-			c := deck[ix]
-			for i := 0; i < H; i++ {
-				hbs[i][j] = c
+	work := make(chan int)
+	// finished receives the number of runouts in each unit of work
+	// as it's finished.
+	finished := make(chan int)
+	counts := make([]*equityCounts, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			whbs := append([][7]Card{}, hbs...)
+			evs := make([]int16, len(hands))
+			counts[w] = newEquityCounts(len(hands)).trackCategories(opts.Categories)
+			for u := range work {
+				finished <- ir.runouts(units[u], whbs, evs, counts[w])
 			}
+		}(w)
+	}
+
+	T := 0
+	next, pending := 0, 0
+	done := ctx.Done()
+	for (next < len(units) && err == nil) || pending > 0 {
+		// Stop handing out work once the context is done.
+		var send chan int
+		if next < len(units) && err == nil {
+			send = work
 		}
-		holdemRiverEquities(hbs, evs, eqs)
-		if !incHEIndex(idxs, len(deck)) {
-			break
+		select {
+		case send <- next:
+			next++
+			pending++
+		case n := <-finished:
+			pending--
+			if n > 0 {
+				T += n
+				progress(T, total)
+			}
+		case <-done:
+			err = ctx.Err()
+			done = nil
 		}
 	}
-	for i := range eqs {
-		eqs[i].Equity /= float64(T)
-		eqs[i].Win /= float64(T)
-		eqs[i].Tie /= float64(T)
-		eqs[i].Boards = int(T)
+	close(work)
+	wg.Wait()
+
+	for w := 0; w < workers; w++ {
+		ec.merge(counts[w])
 	}
-	return eqs, nil
+	return ec.equities(T), err
 }
 
 func incHEIndex(idx []int, dl int) bool {
@@ -178,3 +436,31 @@ func incHEIndex(idx []int, dl int) bool {
 	}
 	return false
 }
+
+// forEachRunout calls f with every 5-card board that completes the
+// given board with cards from deck. It returns the number of boards.
+func forEachRunout(deck []Card, board []Card, f func(brd *[5]Card)) int {
+	var brd [5]Card
+	copy(brd[:], board)
+	if len(board) == 5 {
+		f(&brd)
+		return 1
+	}
+
+	idxs := make([]int, 5-len(board))
+	for i := range idxs {
+		idxs[i] = i
+	}
+	T := 0
+	for {
+		T++
+		for j, ix := range idxs {
+			brd[len(board)+j] = deck[ix]
+		}
+		f(&brd)
+		if !incHEIndex(idxs, len(deck)) {
+			break
+		}
+	}
+	return T
+}
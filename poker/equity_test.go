@@ -1,8 +1,11 @@
 package poker
 
 import (
+	"context"
 	"fmt"
 	"math"
+	"math/rand"
+	"reflect"
 	"testing"
 )
 
@@ -107,6 +110,270 @@ func TestEquity(t *testing.T) {
 
 }
 
+func TestEquityWorkers(t *testing.T) {
+	tcs := []struct {
+		hands []string
+		board string
+	}{
+		{hands: []string{"CA HK", "DK HT"}},
+		{hands: []string{"CA HK", "DK HT", "H9 D9"}, board: "D2 H2 S2"},
+		{hands: []string{"CA HK", "DK HT", "H9 D9"}, board: "D2 H2 S2 SK"},
+		{hands: []string{"CA HK", "DK HT", "H9 D9"}, board: "D2 H2 S2 SK CJ"},
+	}
+	for _, tc := range tcs {
+		var hands [][2]Card
+		for _, h := range parseHands(t, tc.hands...) {
+			hands = append(hands, [2]Card{h[0], h[1]})
+		}
+		var board []Card
+		if tc.board != "" {
+			board = parseHands(t, tc.board)[0]
+		}
+		want, err := HoldemEquities(hands, board)
+		if err != nil {
+			t.Fatalf("failed to compute equities: %v", err)
+		}
+		for _, workers := range []int{1, 2, 3, 7, 100} {
+			got, err := HoldemEquitiesWithOptions(hands, board, EquityOptions{Workers: workers})
+			if err != nil {
+				t.Fatalf("failed to compute equities with %d workers: %v", workers, err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("%v on %q with %d workers: got %+v, want %+v", tc.hands, tc.board, workers, got, want)
+			}
+		}
+	}
+}
+
+// bruteHoldemEquities computes holdem equities by evaluating every
+// runout.
+func bruteHoldemEquities(t *testing.T, hands [][2]Card, board []Card, dead ...Card) []Equity {
+	all, err := getRemainingDeck(hands, board)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ds, _ := MakeCardSet(dead...)
+	var deck []Card
+	for _, c := range all {
+		if !ds.Contains(c) {
+			deck = append(deck, c)
+		}
+	}
+	ec := newEquityCounts(len(hands))
+	evs := make([]int16, len(hands))
+	T := forEachRunout(deck, board, func(brd *[5]Card) {
+		for i, h := range hands {
+			h7 := [7]Card{h[0], h[1], brd[0], brd[1], brd[2], brd[3], brd[4]}
+			evs[i] = Eval7(&h7)
+		}
+		ec.add(evs)
+	})
+	return ec.equities(T)
+}
+
+func TestEquitySuitIsomorphism(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	for i := 0; i < 200; i++ {
+		// Mostly flops and turns, with some preflop hands, and
+		// often a deck restricted to a few ranks so that flushes
+		// and paired boards are common.
+		nb := []int{3, 3, 4, 4, 5}[i%5]
+		if i%20 == 0 {
+			nb = 0
+		}
+		var cards []Card
+		for _, p := range rnd.Perm(52) {
+			if Cards[p].Rank() >= 7 || i%2 == 0 {
+				cards = append(cards, Cards[p])
+			}
+		}
+		nh := 2 + i%3
+		hands := make([][2]Card, nh)
+		for j := range hands {
+			hands[j] = [2]Card{cards[2*j], cards[2*j+1]}
+		}
+		board := cards[2*nh : 2*nh+nb]
+		got, err := HoldemEquities(hands, board)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := bruteHoldemEquities(t, hands, board); !reflect.DeepEqual(got, want) {
+			t.Errorf("%v on %v: got %+v, want %+v", hands, Hand(board), got, want)
+		}
+	}
+}
+
+func TestEquityProgress(t *testing.T) {
+	hands := holdemHands(parseHands(t, "CA HK", "DK HT"))
+	board := parseHands(t, "D2 H2 S2")[0]
+	for _, workers := range []int{1, 4} {
+		last, calls := 0, 0
+		opts := EquityOptions{
+			Workers: workers,
+			Progress: func(done, total int) {
+				calls++
+				if total != 45*44/2 || done <= last || done > total {
+					t.Errorf("%d workers: bad progress %d/%d after %d", workers, done, total, last)
+				}
+				last = done
+			},
+		}
+		eqs, err := HoldemEquitiesContext(context.Background(), hands, board, opts)
+		if err != nil {
+			t.Fatalf("failed to compute equities: %v", err)
+		}
+		if last != eqs[0].Boards || calls < 2 {
+			t.Errorf("%d workers: progress got to %d after %d calls, but %d boards evaluated", workers, last, calls, eqs[0].Boards)
+		}
+	}
+}
+
+func TestEquityContextCancel(t *testing.T) {
+	hands := holdemHands(parseHands(t, "CA HK", "DK HT"))
+	for _, workers := range []int{1, 4} {
+		ctx, cancel := context.WithCancel(context.Background())
+		opts := EquityOptions{
+			Workers: workers,
+			Progress: func(done, total int) {
+				cancel()
+			},
+		}
+		eqs, err := HoldemEquitiesContext(ctx, hands, nil, opts)
+		if err != context.Canceled {
+			t.Errorf("%d workers: got error %v, want %v", workers, err, context.Canceled)
+		}
+		if eqs[0].Boards == 0 || eqs[0].Boards >= 1712304 {
+			t.Errorf("%d workers: got %d boards, expected a partial result", workers, eqs[0].Boards)
+		}
+		if total := eqs[0].Equity + eqs[1].Equity; math.Abs(total-1) > 1e-9 {
+			t.Errorf("%d workers: partial equities add up to %f", workers, total)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	eqs, err := HoldemEquitiesContext(ctx, hands, nil, EquityOptions{})
+	if err != context.Canceled || eqs[0].Boards != 0 {
+		t.Errorf("with cancelled context got %+v, %v", eqs, err)
+	}
+}
+
+func TestEquityDeadCards(t *testing.T) {
+	hands := holdemHands(parseHands(t, "CA HK", "DK HT", "H9 D9"))
+	board := parseHands(t, "D2 HQ S7")[0]
+	dead := parseHands(t, "SK SQ C9 HJ")[0]
+	want := bruteHoldemEquities(t, hands, board, dead...)
+	for _, workers := range []int{1, 4} {
+		got, err := HoldemEquitiesWithOptions(hands, board, EquityOptions{Workers: workers, Dead: dead})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%d workers: got %+v, want %+v", workers, got, want)
+		}
+	}
+
+	// With all but one card dead on the turn, the river is known.
+	turn := parseHands(t, "D2 HQ S7 C3")[0]
+	deck, err := getRemainingDeck(hands, turn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	river := append(append([]Card(nil), turn...), deck[0])
+	want, err = HoldemEquities(hands, river)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ests, err := HoldemEquitiesSampled(hands, turn, SampleOptions{Samples: 100, Dead: deck[1:]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, e := range ests {
+		if e.Equity.Equity != want[i].Equity {
+			t.Errorf("sampled equity of hand %d with one card left is %f, want %f", i, e.Equity.Equity, want[i].Equity)
+		}
+	}
+
+	for _, bad := range [][]Card{
+		parseHands(t, "CA")[0],
+		parseHands(t, "S7")[0],
+		parseHands(t, "SK SK")[0],
+		{Card(200)},
+		deck,
+	} {
+		if _, err := HoldemEquitiesWithOptions(hands, turn, EquityOptions{Dead: bad}); err == nil {
+			t.Errorf("expected error for dead cards %v", bad)
+		}
+		if _, err := HoldemEquitiesSampled(hands, turn, SampleOptions{Samples: 100, Dead: bad}); err == nil {
+			t.Errorf("expected error for dead cards %v when sampling", bad)
+		}
+	}
+}
+
+func TestEquityCategories(t *testing.T) {
+	hands := holdemHands(parseHands(t, "CA HK", "DK HT", "H9 D9"))
+	board := parseHands(t, "D2 H2 S7")[0]
+	deck, err := getRemainingDeck(hands, board)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Count the categories by evaluating every runout.
+	want := make([]CategoryCounts, len(hands))
+	evs := make([]int16, len(hands))
+	forEachRunout(deck, board, func(brd *[5]Card) {
+		best, n := int16(-1), 0
+		for i, h := range hands {
+			h7 := [7]Card{h[0], h[1], brd[0], brd[1], brd[2], brd[3], brd[4]}
+			evs[i] = Eval7(&h7)
+			if evs[i] > best {
+				best, n = evs[i], 1
+			} else if evs[i] == best {
+				n++
+			}
+		}
+		for i, ev := range evs {
+			c := scoreCategory(ev)
+			want[i].Hands[c]++
+			if ev == best && n == 1 {
+				want[i].Wins[c]++
+			} else if ev == best {
+				want[i].Ties[c]++
+			}
+		}
+	})
+	for _, workers := range []int{1, 4} {
+		eqs, err := HoldemEquitiesWithOptions(hands, board, EquityOptions{Workers: workers, Categories: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, e := range eqs {
+			if e.Categories == nil || *e.Categories != want[i] {
+				t.Errorf("%d workers: hand %d has categories %+v, want %+v", workers, i, e.Categories, want[i])
+			}
+		}
+	}
+
+	// Preflop, and without categories.
+	eqs, err := HoldemEquitiesWithOptions(hands[:2], nil, EquityOptions{Categories: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, e := range eqs {
+		var hands, wins, ties int
+		for c := range e.Categories.Hands {
+			hands += e.Categories.Hands[c]
+			wins += e.Categories.Wins[c]
+			ties += e.Categories.Ties[c]
+		}
+		if hands != e.Boards || math.Abs(float64(wins)-e.Win*float64(e.Boards)) > 0.5 || math.Abs(float64(ties)-e.Tie*float64(e.Boards)) > 0.5 {
+			t.Errorf("preflop hand %d has categories %+v, but equity %+v", i, e.Categories, e)
+		}
+	}
+	if eqs, err := HoldemEquities(hands, board); err != nil || eqs[0].Categories != nil {
+		t.Errorf("got categories %+v (%v), but didn't ask for them", eqs[0].Categories, err)
+	}
+}
+
 func BenchmarkHoldemEquitiesPreflop(b *testing.B) {
 	b.ResetTimer()
 	card := func(s string) Card {
@@ -300,6 +300,7 @@ type evalInfos struct {
 	rankTo5          [ScoreMax + 1][]Card
 	rankTo3          [ScoreMax + 1][]Card
 	slowRankToPacked map[int]int16
+	packedToSlowRank [ScoreMax + 1]int
 }
 
 var evalInfo *evalInfos = makeEvalInfo()
@@ -322,6 +323,38 @@ func EvalToHand3(e int16) ([]Card, bool) {
 	return evalInfo.rankTo3[e], len(evalInfo.rankTo3[e]) != 0
 }
 
+// BestFive returns the five cards from a 7-card poker hand that make
+// the best hand, and the two cards that aren't used. Eval5 of the five
+// cards is the same as Eval7 of the hand. If there's more than one way
+// to make the best hand, for example when an unused card has the
+// same rank as a kicker, the cards earliest in the hand are used.
+// The cards are in the same order as in the hand.
+func BestFive(hand *[7]Card) (best [5]Card, unused [2]Card) {
+	return bestFive(hand, Eval5, Eval7(hand))
+}
+
+// bestFive returns the five cards from the 7-card hand that have the
+// given evaluation, and the two cards that aren't used.
+func bestFive(hand *[7]Card, eval func(*[5]Card) int16, want int16) (best [5]Card, unused [2]Card) {
+	// Drop the latest cards first, so that the earliest cards
+	// are kept.
+	for a := 5; a >= 0; a-- {
+		for b := 6; b > a; b-- {
+			k := 0
+			for i := 0; i < 7; i++ {
+				if i != a && i != b {
+					best[k] = hand[i]
+					k++
+				}
+			}
+			if eval(&best) == want {
+				return best, [2]Card{hand[a], hand[b]}
+			}
+		}
+	}
+	panic(fmt.Sprintf("no five cards from %v evaluate to %d", Hand(hand[:]), want))
+}
+
 // EvalSlow takes a 3-, 5- or 7- card poker hand and returns a number
 // which can be used to rank it against other poker hands.
 // The returned value is in the range 0 to ScoreMax.
@@ -445,6 +478,7 @@ func makeEvalInfo() *evalInfos {
 	for rank, packedRank := range ei.slowRankToPacked {
 		ei.rankTo5[packedRank] = hand5[rank]
 		ei.rankTo3[packedRank] = hand3[rank]
+		ei.packedToSlowRank[packedRank] = rank
 	}
 	if ScoreMax != len(allScores)-1 {
 		log.Fatalf("Expected max score of %d, but found %d", ScoreMax, len(allScores)-1)
@@ -1,6 +1,9 @@
 package poker
 
-import "testing"
+import (
+	"math/rand"
+	"testing"
+)
 
 func BenchmarkEvalInfo(b *testing.B) {
 	T := 0
@@ -9,3 +12,46 @@ func BenchmarkEvalInfo(b *testing.B) {
 		T += int(ei.slowRankToPacked[0])
 	}
 }
+
+func TestBestFive(t *testing.T) {
+	tcs := []struct {
+		hand       string
+		wantBest   string
+		wantUnused string
+	}{
+		{"SA HA D2 C7 SK DA C9", "SA HA SK DA C9", "D2 C7"},
+		{"H2 H9 HK D5 HT C3 H4", "H2 H9 HK HT H4", "D5 C3"},
+		// Either ten makes the straight, and the first one is used.
+		{"S5 H6 C7 D8 S9 ST DT", "H6 C7 D8 S9 ST", "S5 DT"},
+		// The five cards on the board play.
+		{"C2 D3 SA HK SQ DJ CT", "SA HK SQ DJ CT", "C2 D3"},
+		{"C8 D8 SA H8 S2 D2 C2", "C8 D8 H8 S2 D2", "SA C2"},
+	}
+	for _, tc := range tcs {
+		var h7 [7]Card
+		copy(h7[:], parseHands(t, tc.hand)[0])
+		best, unused := BestFive(&h7)
+		if got := Hand(best[:]).String(); got != tc.wantBest {
+			t.Errorf("BestFive(%s) best = %s, want %s", tc.hand, got, tc.wantBest)
+		}
+		if got := Hand(unused[:]).String(); got != tc.wantUnused {
+			t.Errorf("BestFive(%s) unused = %s, want %s", tc.hand, got, tc.wantUnused)
+		}
+	}
+
+	rnd := rand.New(rand.NewSource(42))
+	for i := 0; i < 10000; i++ {
+		var h7 [7]Card
+		for j, p := range rnd.Perm(52)[:7] {
+			h7[j] = Cards[p]
+		}
+		best, unused := BestFive(&h7)
+		if Eval5(&best) != Eval7(&h7) {
+			t.Errorf("BestFive(%v) = %v, which evaluates to %d, want %d", Hand(h7[:]), Hand(best[:]), Eval5(&best), Eval7(&h7))
+		}
+		s, err := MakeCardSet(append(best[:], unused[:]...)...)
+		if err != nil || s.Count() != 7 || EvalSet(s) != Eval7(&h7) {
+			t.Errorf("BestFive(%v) = %v, %v doesn't use all the cards", Hand(h7[:]), Hand(best[:]), Hand(unused[:]))
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// +build ignore
+
+// gen_preflop.go generates preflop_data.go, which contains the
+// equities of every heads-up preflop matchup. It takes a while to run.
+//   go run gen_preflop.go
+
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"math"
+	"os"
+	"runtime"
+	"sync"
+
+	"github.com/paulhankin/poker/v2/poker"
+)
+
+func main() {
+	keys, hands := poker.InternalPreflopMatchups()
+	fmt.Printf("computing equities of %d matchups\n", len(keys))
+	wins := make([]uint64, len(keys))
+	ties := make([]uint64, len(keys))
+
+	work := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < runtime.NumCPU(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				eqs, err := poker.HoldemEquities(hands[i][:], nil)
+				if err != nil {
+					log.Fatalf("failed to compute equities of %v: %v", hands[i], err)
+				}
+				n := float64(eqs[0].Boards)
+				wins[i] = uint64(math.Round(eqs[0].Win * n))
+				ties[i] = uint64(math.Round(eqs[0].Tie * n))
+			}
+		}()
+	}
+	for i := range keys {
+		if i%1000 == 0 {
+			fmt.Printf("%d/%d\n", i, len(keys))
+		}
+		work <- i
+	}
+	close(work)
+	wg.Wait()
+
+	rf, err := os.Create("preflop_data.go")
+	if err != nil {
+		log.Fatalf("failed to create source file: %v", err)
+	}
+	f := bufio.NewWriter(rf)
+	if _, err := fmt.Fprint(f, `// Code generated by gen_preflop.go. DO NOT EDIT.
+
+package poker
+
+const preflopData = "`); err != nil {
+		log.Fatal(err)
+	}
+	e64 := base64.NewEncoder(base64.RawStdEncoding, f)
+	zs := gzip.NewWriter(e64)
+	var buf [binary.MaxVarintLen64]byte
+	put := func(v uint64) {
+		n := binary.PutUvarint(buf[:], v)
+		if _, err := zs.Write(buf[:n]); err != nil {
+			log.Fatal(err)
+		}
+	}
+	put(uint64(len(keys)))
+	prev := uint32(0)
+	for i, k := range keys {
+		put(uint64(k - prev))
+		put(wins[i])
+		put(ties[i])
+		prev = k
+	}
+	if err := zs.Close(); err != nil {
+		log.Fatalf("failed to close gzip: %v", err)
+	}
+	if err := e64.Close(); err != nil {
+		log.Fatalf("failed to close base64 encoder: %v", err)
+	}
+	if _, err := fmt.Fprint(f, "\"\n"); err != nil {
+		log.Fatal(err)
+	}
+	if err := f.Flush(); err != nil {
+		log.Fatalf("failed to flush data: %v", err)
+	}
+	if err := rf.Close(); err != nil {
+		log.Fatalf("failed to close file: %v", err)
+	}
+}
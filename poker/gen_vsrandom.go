@@ -0,0 +1,100 @@
+// +build ignore
+
+// gen_vsrandom.go generates vsrandom_data.go, which contains the
+// preflop equities of each class of starting hand against 1 to 9
+// random hands. It needs the preflop equities in preflop_data.go,
+// and takes a while to run.
+//   go run gen_vsrandom.go
+
+package main
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"os"
+	"runtime"
+	"sync"
+
+	"github.com/paulhankin/poker/v2/poker"
+)
+
+// targetStdErr is the standard error that equities against 2 or more
+// random hands are estimated to.
+const targetStdErr = 0.001
+
+func main() {
+	hands := poker.InternalStartingHands()
+	fmt.Printf("computing equities of %d starting hands\n", len(hands))
+	eqs := make([]uint64, len(hands)*poker.MaxRandomOpponents)
+
+	work := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < runtime.NumCPU(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range work {
+				h, n := hands[i/poker.MaxRandomOpponents], i%poker.MaxRandomOpponents+1
+				e, err := poker.EquityVsRandom(h, nil, n, poker.SampleOptions{
+					TargetStdErr: targetStdErr,
+					Rand:         rand.New(rand.NewSource(int64(i))),
+				})
+				if err != nil {
+					log.Fatalf("failed to compute equity of %v against %d hands: %v", h, n, err)
+				}
+				eqs[i] = uint64(math.Round(e.Equity.Equity * 1e6))
+			}
+		}()
+	}
+	for i := range eqs {
+		if i%100 == 0 {
+			fmt.Printf("%d/%d\n", i, len(eqs))
+		}
+		work <- i
+	}
+	close(work)
+	wg.Wait()
+
+	rf, err := os.Create("vsrandom_data.go")
+	if err != nil {
+		log.Fatalf("failed to create source file: %v", err)
+	}
+	f := bufio.NewWriter(rf)
+	if _, err := fmt.Fprint(f, `// Code generated by gen_vsrandom.go. DO NOT EDIT.
+
+package poker
+
+const vsRandomData = "`); err != nil {
+		log.Fatal(err)
+	}
+	e64 := base64.NewEncoder(base64.RawStdEncoding, f)
+	zs := gzip.NewWriter(e64)
+	var buf [binary.MaxVarintLen64]byte
+	for _, e := range eqs {
+		n := binary.PutUvarint(buf[:], e)
+		if _, err := zs.Write(buf[:n]); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := zs.Close(); err != nil {
+		log.Fatalf("failed to close gzip: %v", err)
+	}
+	if err := e64.Close(); err != nil {
+		log.Fatalf("failed to close base64 encoder: %v", err)
+	}
+	if _, err := fmt.Fprint(f, "\"\n"); err != nil {
+		log.Fatal(err)
+	}
+	if err := f.Flush(); err != nil {
+		log.Fatalf("failed to flush data: %v", err)
+	}
+	if err := rf.Close(); err != nil {
+		log.Fatalf("failed to close file: %v", err)
+	}
+}
@@ -33,6 +33,10 @@ type genner struct {
 	cache map[hand64Canonical]*tblNode
 	work  chan genwork
 	wg    sync.WaitGroup
+
+	ncards int
+	deck   []Card               // the cards that can appear in a hand
+	eval   func(c []Card) int16 // ranks a complete hand of ncards cards
 }
 
 func (g *genner) get(key hand64Canonical) (*tblNode, bool) {
@@ -85,7 +89,8 @@ func gentreeEval7(c *[7]Card) int16 {
 	}
 }
 
-func (g *genner) genworker(ncards int) {
+func (g *genner) genworker() {
+	ncards := g.ncards
 	for w := range g.work {
 		h := w.h
 		n := w.n
@@ -98,27 +103,15 @@ func (g *genner) genworker(ncards int) {
 		}
 		node.N = n
 		node.H = h
-		for c := 0; c < 52; c++ {
-			nh, ok := h.Add(n, Card(c))
+		for _, c := range g.deck {
+			nh, ok := h.Add(n, c)
 			if !ok {
 				continue
 			}
 			nhc, xf := nh.CanonicalWithTransform(n+1, ncards)
 			if n == ncards-1 {
-				var rank int16
-				if ncards == 7 {
-					var c7 [7]Card
-					copy(c7[:], nhc.Exemplar(7).CardsN(7))
-					rank = gentreeEval7(&c7)
-				} else if ncards == 5 {
-					var c5 [5]Card
-					copy(c5[:], nhc.Exemplar(5).CardsN(5))
-					rank = EvalSlow(c5[:])
-				} else {
-					panic(ncards)
-				}
 				node.T[c] = tblTransition{
-					rank: rank,
+					rank: g.eval(nhc.Exemplar(ncards).CardsN(ncards)),
 				}
 			} else {
 				node.T[c] = tblTransition{
@@ -135,7 +128,9 @@ func (g *genner) genworker(ncards int) {
 	}
 }
 
-func indexNodes(node *tblNode) {
+// indexNodes assigns indexes to the nodes reachable from node,
+// and returns the number of nodes.
+func indexNodes(node *tblNode) int {
 	done := map[*tblNode]bool{}
 	nodes := []*tblNode{node}
 	for i := 0; i < len(nodes); i++ {
@@ -149,19 +144,39 @@ func indexNodes(node *tblNode) {
 			nodes = append(nodes, nn)
 		}
 	}
+	return len(nodes)
 }
 
 func gentree(ncards int) *tblNode {
+	eval := func(c []Card) int16 {
+		if ncards == 7 {
+			var c7 [7]Card
+			copy(c7[:], c)
+			return gentreeEval7(&c7)
+		} else if ncards == 5 {
+			return EvalSlow(c)
+		}
+		panic(ncards)
+	}
+	return gentreeDeck(ncards, Cards, eval)
+}
+
+// gentreeDeck generates the state machine for hands of ncards cards
+// drawn from the given deck, where eval ranks complete hands.
+func gentreeDeck(ncards int, deck []Card, eval func(c []Card) int16) *tblNode {
 	g := &genner{
-		cache: map[hand64Canonical]*tblNode{},
-		work:  make(chan genwork, 10_000_000),
+		cache:  map[hand64Canonical]*tblNode{},
+		work:   make(chan genwork, 10_000_000),
+		ncards: ncards,
+		deck:   deck,
+		eval:   eval,
 	}
 	g.wg.Add(1)
 	var wg sync.WaitGroup
 	for i := 0; i < runtime.NumCPU(); i++ {
 		wg.Add(1)
 		go func() {
-			g.genworker(ncards)
+			g.genworker()
 			wg.Done()
 		}()
 	}
@@ -174,6 +189,34 @@ func gentree(ncards int) *tblNode {
 	return node
 }
 
+// genTables fills indextable with the flattened form of the state
+// machine rooted at node. Each node has 52 entries, one for each
+// card. For terminal nodes, the entries are the ranks of the hands.
+// For other nodes, the entries are the index of the next node's
+// entries in the upper bits, and the suit transform in the bottom 8 bits.
+// It returns the number of non-terminal nodes.
+func genTables(ncards int, indextable []uint32, node *tblNode, done []bool) int {
+	table := indextable[node.Index*52 : (node.Index+1)*52]
+	if node.N == ncards-1 {
+		for i, t := range node.T {
+			table[i] = uint32(t.rank)
+		}
+		return 0
+	}
+	S := 0
+	for i, t := range node.T {
+		if t.N == nil {
+			continue
+		}
+		table[i] = (uint32(t.N.Index*52) << 8) | uint32(t.SX.Byte())
+		if !done[t.N.Index] {
+			done[t.N.Index] = true
+			S += genTables(ncards, indextable, t.N, done)
+		}
+	}
+	return 1 + S
+}
+
 var (
 	rootNode5card     *tblNode
 	rootNode5cardInit sync.Once
@@ -0,0 +1,177 @@
+package poker
+
+import "fmt"
+
+// HiLoEquity contains information about poker hand equity in a
+// split-pot game, where half the pot goes to the best high hand, and
+// half to the best 8-or-better ace-to-five low hand. If no hand
+// qualifies for low, the high hand wins the whole pot.
+type HiLoEquity struct {
+	Equity float64 `json:"equity"` // total equity in the pot
+	High   float64 `json:"high"`   // equity gained from the high half of the pot
+	Low    float64 `json:"low"`    // equity gained from the low half of the pot
+	Scoop  float64 `json:"scoop"`  // probability of winning the whole pot outright
+	Boards int     `json:"boards"` // how many runouts were computed
+}
+
+// hiLoRiverEquities adds to eqs the equity each hand gets on a single
+// runout, given the high and low evaluations of the hands.
+func hiLoRiverEquities(his, los []int16, eqs []HiLoEquity) {
+	H := len(his)
+	hiCount, loCount := 0, 0
+	var bestHi, bestLo int16 = -1000, -1000
+	for i := 0; i < H; i++ {
+		if his[i] > bestHi {
+			hiCount = 1
+			bestHi = his[i]
+		} else if his[i] == bestHi {
+			hiCount++
+		}
+		if !QualifiesLow8(los[i]) {
+			continue
+		}
+		if los[i] > bestLo {
+			loCount = 1
+			bestLo = los[i]
+		} else if los[i] == bestLo {
+			loCount++
+		}
+	}
+
+	hiPot := 1.0
+	if loCount > 0 {
+		hiPot = 0.5
+	}
+	for i := 0; i < H; i++ {
+		hi := his[i] == bestHi
+		lo := loCount > 0 && los[i] == bestLo
+		if hi {
+			eqs[i].High += hiPot / float64(hiCount)
+		}
+		if lo {
+			eqs[i].Low += 0.5 / float64(loCount)
+		}
+		if hi && hiCount == 1 && (loCount == 0 || lo && loCount == 1) {
+			eqs[i].Scoop++
+		}
+	}
+}
+
+// normalizeHiLoEquities converts equity totals accumulated over T runouts
+// into averages.
+func normalizeHiLoEquities(eqs []HiLoEquity, T int) {
+	for i := range eqs {
+		eqs[i].High /= float64(T)
+		eqs[i].Low /= float64(T)
+		eqs[i].Scoop /= float64(T)
+		eqs[i].Equity = eqs[i].High + eqs[i].Low
+		eqs[i].Boards = T
+	}
+}
+
+// OmahaHiLoEquities returns the river equities for the given Omaha
+// hands in a high/low 8-or-better split-pot game (Omaha-8), given a
+// board of up to 5 cards.
+// The hands and board must be distinct, and the board can't have more
+// than 5 cards in it.
+func OmahaHiLoEquities(hands [][4]Card, board []Card) ([]HiLoEquity, error) {
+	hs := make([]Hand, len(hands))
+	for i := range hands {
+		hs[i] = hands[i][:]
+	}
+	deck, err := remainingDeck(hs, board)
+	if err != nil {
+		return nil, err
+	}
+
+	eqs := make([]HiLoEquity, len(hands))
+	his := make([]int16, len(hands))
+	los := make([]int16, len(hands))
+	T := forEachRunout(deck, board, func(brd *[5]Card) {
+		for i := range hands {
+			his[i] = EvalOmaha(&hands[i], brd)
+			los[i] = EvalOmahaLow(&hands[i], brd)
+		}
+		hiLoRiverEquities(his, los, eqs)
+	})
+	normalizeHiLoEquities(eqs, T)
+	return eqs, nil
+}
+
+// StudHiLoEquities returns the equities for the given seven-card stud
+// hands in a high/low 8-or-better split-pot game (Stud-8). Each hand
+// has up to 7 cards, and every way of dealing the remaining cards to
+// the hands is considered. The hands must be distinct.
+// The number of runouts grows very quickly with the number of cards
+// still to come, so this is only practical on later streets.
+func StudHiLoEquities(hands []Hand) ([]HiLoEquity, error) {
+	deck, err := remainingDeck(hands, nil)
+	if err != nil {
+		return nil, err
+	}
+	need := 0
+	for i, h := range hands {
+		if len(h) > 7 {
+			return nil, fmt.Errorf("hand %d has more than 7 (%d) cards", i, len(h))
+		}
+		need += 7 - len(h)
+	}
+	if need > len(deck) {
+		return nil, fmt.Errorf("not enough cards to deal: need %d, but %d remaining", need, len(deck))
+	}
+
+	h7s := make([][7]Card, len(hands))
+	for i, h := range hands {
+		copy(h7s[i][:], h)
+	}
+	eqs := make([]HiLoEquity, len(hands))
+	his := make([]int16, len(hands))
+	los := make([]int16, len(hands))
+	used := make([]bool, len(deck))
+
+	T := 0
+	// deal deals the remaining cards to hand i onwards.
+	var deal func(i int)
+	deal = func(i int) {
+		if i == len(hands) {
+			T++
+			for j := range h7s {
+				his[j] = Eval7(&h7s[j])
+				los[j] = EvalLow7(&h7s[j])
+			}
+			hiLoRiverEquities(his, los, eqs)
+			return
+		}
+		n := len(hands[i])
+		if n == 7 {
+			deal(i + 1)
+			return
+		}
+		var avail []int
+		for j := range deck {
+			if !used[j] {
+				avail = append(avail, j)
+			}
+		}
+		idxs := make([]int, 7-n)
+		for j := range idxs {
+			idxs[j] = j
+		}
+		for {
+			for j, ix := range idxs {
+				h7s[i][n+j] = deck[avail[ix]]
+				used[avail[ix]] = true
+			}
+			deal(i + 1)
+			for _, ix := range idxs {
+				used[avail[ix]] = false
+			}
+			if !incHEIndex(idxs, len(avail)) {
+				break
+			}
+		}
+	}
+	deal(0)
+	normalizeHiLoEquities(eqs, T)
+	return eqs, nil
+}
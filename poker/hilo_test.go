@@ -0,0 +1,141 @@
+package poker
+
+import (
+	"math"
+	"testing"
+)
+
+func omahaHands(hs []Hand) [][4]Card {
+	r := make([][4]Card, len(hs))
+	for i, h := range hs {
+		copy(r[i][:], h)
+	}
+	return r
+}
+
+func hiLoClose(a, b HiLoEquity) bool {
+	const eps = 1e-9
+	return a.Boards == b.Boards &&
+		math.Abs(a.Equity-b.Equity) < eps &&
+		math.Abs(a.High-b.High) < eps &&
+		math.Abs(a.Low-b.Low) < eps &&
+		math.Abs(a.Scoop-b.Scoop) < eps
+}
+
+func TestOmahaHiLoRiver(t *testing.T) {
+	tcs := []struct {
+		name  string
+		hands []string
+		board string
+		want  []HiLoEquity
+	}{
+		{
+			name:  "scoop",
+			hands: []string{"SA S4 HK DK", "CQ HQ D9 C9"},
+			board: "H2 D3 C7 SK DQ",
+			want: []HiLoEquity{
+				{Equity: 1, High: 0.5, Low: 0.5, Scoop: 1, Boards: 1},
+				{Boards: 1},
+			},
+		},
+		{
+			name:  "no qualifying low",
+			hands: []string{"SA ST H2 H3", "S8 C8 H4 H5"},
+			board: "HK DQ CJ S9 D9",
+			want: []HiLoEquity{
+				{Equity: 1, High: 1, Scoop: 1, Boards: 1},
+				{Boards: 1},
+			},
+		},
+		{
+			name:  "quartered",
+			hands: []string{"SA S4 HK DK", "CA C4 D8 D9"},
+			board: "H2 D3 C7 SK DQ",
+			want: []HiLoEquity{
+				{Equity: 0.75, High: 0.5, Low: 0.25, Boards: 1},
+				{Equity: 0.25, Low: 0.25, Boards: 1},
+			},
+		},
+		{
+			name:  "split high, one low",
+			hands: []string{"SA S2 HK HQ", "CK CQ D8 D9"},
+			board: "H3 D4 C7 SK DQ",
+			want: []HiLoEquity{
+				{Equity: 0.75, High: 0.25, Low: 0.5, Boards: 1},
+				{Equity: 0.25, High: 0.25, Boards: 1},
+			},
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			hands := omahaHands(parseHands(t, tc.hands...))
+			board := parseHands(t, tc.board)[0]
+			eqs, err := OmahaHiLoEquities(hands, board)
+			if err != nil {
+				t.Fatalf("failed to compute equities: %v", err)
+			}
+			for i := range tc.want {
+				if !hiLoClose(eqs[i], tc.want[i]) {
+					t.Errorf("hand %s: got %+v, want %+v", tc.hands[i], eqs[i], tc.want[i])
+				}
+			}
+		})
+	}
+}
+
+func checkHiLoTotals(t *testing.T, eqs []HiLoEquity, wantBoards int) {
+	var total, high, low float64
+	for _, eq := range eqs {
+		if eq.Boards != wantBoards {
+			t.Errorf("got %d boards, want %d", eq.Boards, wantBoards)
+		}
+		if eq.Scoop > eq.Equity {
+			t.Errorf("scoop %f is more than equity %f", eq.Scoop, eq.Equity)
+		}
+		total += eq.Equity
+		high += eq.High
+		low += eq.Low
+	}
+	if math.Abs(total-1) > 1e-9 {
+		t.Errorf("total equity is %f, want 1", total)
+	}
+	// The high half of the pot is always awarded, so gets
+	// at least half the pot.
+	if high < 0.5 || math.Abs(high+low-1) > 1e-9 {
+		t.Errorf("high equity is %f and low equity is %f", high, low)
+	}
+}
+
+func TestOmahaHiLoFlop(t *testing.T) {
+	hands := omahaHands(parseHands(t, "SA S2 HK HQ", "CK CQ D8 D9", "C3 C4 H5 H6"))
+	board := parseHands(t, "H3 D4 C7")[0]
+	eqs, err := OmahaHiLoEquities(hands, board)
+	if err != nil {
+		t.Fatalf("failed to compute equities: %v", err)
+	}
+	checkHiLoTotals(t, eqs, 37*36/2)
+}
+
+func TestStudHiLoEquities(t *testing.T) {
+	hands := parseHands(t, "SA S2 S3 HK S9 D4 S7", "CK CQ D8 CT H2 H3 DK")
+	eqs, err := StudHiLoEquities(hands)
+	if err != nil {
+		t.Fatalf("failed to compute equities: %v", err)
+	}
+	// The first hand has a spade flush and a 7-4-3-2-A low.
+	want := HiLoEquity{Equity: 1, High: 0.5, Low: 0.5, Scoop: 1, Boards: 1}
+	if !hiLoClose(eqs[0], want) {
+		t.Errorf("got %+v, want %+v", eqs[0], want)
+	}
+
+	hands = parseHands(t, "SA S2 S3 HK D9 D4", "CK CQ D8 CT H2 H3")
+	eqs, err = StudHiLoEquities(hands)
+	if err != nil {
+		t.Fatalf("failed to compute equities: %v", err)
+	}
+	checkHiLoTotals(t, eqs, 40*39)
+
+	if _, err := StudHiLoEquities(parseHands(t, "SA S2 S3 HK D9 D4", "CK CQ D8 CT H2 S3")); err == nil {
+		t.Errorf("expected error for duplicate cards")
+	}
+}
@@ -0,0 +1,305 @@
+package poker
+
+import (
+	"log"
+	"sort"
+	"sync"
+)
+
+// LowScoreMax is the largest possible rank of hand returned by the
+// ace-to-five lowball Eval functions.
+const LowScoreMax = 6174
+
+// Low8Min is the smallest rank of an ace-to-five lowball hand that
+// qualifies as an 8-or-better low.
+const Low8Min = LowScoreMax - 55
+
+// In ace-to-five lowball, straights and flushes don't count and aces are
+// low, so a hand is ranked only by the ranks of its cards. The lowball
+// evaluators use a state machine like the one used by Eval7, but
+// with only 13 transitions out of each state (one for each rank),
+// and no suit transforms. A state is the multiset of ranks seen so far.
+
+// lowRanks counts the number of cards of each rank in a hand.
+// Index 0 is aces, and index 12 is kings.
+type lowRanks [13]uint8
+
+// key packs a multiset of ranks into an int.
+func (lr *lowRanks) key() uint64 {
+	var k uint64
+	for _, n := range lr {
+		k = k<<3 | uint64(n)
+	}
+	return k
+}
+
+// lowBadness returns a number describing how bad a 5-card low hand
+// is: higher numbers are worse. It works like evalScore5 in that
+// the hand type is stored above 5 nibbles describing the ranks,
+// which are ordered with bigger groups first, then higher ranks.
+func lowBadness(lr *lowRanks) int {
+	groups, top := 0, uint8(0)
+	for _, n := range lr {
+		if n > 0 {
+			groups++
+		}
+		if n > top {
+			top = n
+		}
+	}
+	var v int
+	switch {
+	case groups == 5: // no pair
+		v = 0
+	case groups == 4: // one pair
+		v = 1
+	case groups == 3 && top == 2: // two pair
+		v = 2
+	case groups == 3: // trips
+		v = 3
+	case top == 3: // full house
+		v = 4
+	default: // quads
+		v = 5
+	}
+	for n := uint8(4); n > 0; n-- {
+		for r := 12; r >= 0; r-- {
+			if lr[r] == n {
+				v = v*16 + r + 1
+			}
+		}
+	}
+	for i := groups; i < 5; i++ {
+		v *= 16
+	}
+	return v
+}
+
+// bestLowBadness returns the badness of the best 5-card low hand
+// that can be made from the given multiset of ranks.
+func bestLowBadness(lr *lowRanks) int {
+	// Usually the best hand is the lowest 5 distinct ranks, or with
+	// only 4 distinct ranks, those 4 with the lowest possible pair.
+	var sub lowRanks
+	d := 0
+	for r, n := range lr {
+		if n > 0 && d < 5 {
+			sub[r] = 1
+			d++
+		}
+	}
+	if d == 5 {
+		return lowBadness(&sub)
+	}
+	if d == 4 {
+		for r, n := range lr {
+			if n >= 2 {
+				sub[r] = 2
+				return lowBadness(&sub)
+			}
+		}
+	}
+
+	// Otherwise, try every 5-card subset.
+	sub = lowRanks{}
+	best := -1
+	var rec func(r, need int)
+	rec = func(r, need int) {
+		if need == 0 {
+			if b := lowBadness(&sub); best < 0 || b < best {
+				best = b
+			}
+			return
+		}
+		if r == 13 {
+			return
+		}
+		for n := 0; n <= int(lr[r]) && n <= need; n++ {
+			sub[r] = uint8(n)
+			rec(r+1, need-n)
+		}
+		sub[r] = 0
+	}
+	rec(0, 5)
+	return best
+}
+
+type lowTables struct {
+	badnessToScore map[int]int16
+	// tbl5 and tbl7 are the state machines for 5- and 7-card hands.
+	// For non-terminal states, the entry is the index of the
+	// next state. For terminal states, it's the rank of the hand.
+	tbl5, tbl7 []int32
+}
+
+var (
+	lowInfo     *lowTables
+	lowInfoInit sync.Once
+)
+
+// lowTbls returns the lowball tables, building them on first use.
+func lowTbls() *lowTables {
+	lowInfoInit.Do(func() {
+		lowInfo = makeLowTables()
+	})
+	return lowInfo
+}
+
+// genLowTable builds the state machine for ncards-card lowball hands.
+func genLowTable(ncards int, badnessToScore map[int]int16) []int32 {
+	var tbl []int32
+	nodes := []lowRanks{{}}
+	index := map[uint64]int{0: 0}
+	scores := map[uint64]int32{} // cache of scores of terminal states
+	for i := 0; i < len(nodes); i++ {
+		tbl = append(tbl, make([]int32, 13)...)
+		n := 0
+		for _, c := range nodes[i] {
+			n += int(c)
+		}
+		for r := 0; r < 13; r++ {
+			if nodes[i][r] == 4 {
+				continue
+			}
+			next := nodes[i]
+			next[r]++
+			k := next.key()
+			if n == ncards-1 {
+				score, ok := scores[k]
+				if !ok {
+					score = int32(badnessToScore[bestLowBadness(&next)])
+					scores[k] = score
+				}
+				tbl[i*13+r] = score
+				continue
+			}
+			ni, ok := index[k]
+			if !ok {
+				ni = len(nodes)
+				index[k] = ni
+				nodes = append(nodes, next)
+			}
+			tbl[i*13+r] = int32(ni * 13)
+		}
+	}
+	return tbl
+}
+
+func makeLowTables() *lowTables {
+	// Enumerate all 5-card multisets of ranks, and pack
+	// their badness into scores, best hands highest.
+	var bads []int
+	var lr lowRanks
+	var rec func(r, need int)
+	rec = func(r, need int) {
+		if need == 0 {
+			bads = append(bads, lowBadness(&lr))
+			return
+		}
+		if r == 13 {
+			return
+		}
+		for n := 0; n <= 4 && n <= need; n++ {
+			lr[r] = uint8(n)
+			rec(r+1, need-n)
+		}
+		lr[r] = 0
+	}
+	rec(0, 5)
+	sort.Sort(sort.Reverse(sort.IntSlice(bads)))
+	if LowScoreMax != len(bads)-1 {
+		log.Fatalf("Expected max low score of %d, but found %d", LowScoreMax, len(bads)-1)
+	}
+	lt := &lowTables{badnessToScore: map[int]int16{}}
+	for i, b := range bads {
+		lt.badnessToScore[b] = int16(i)
+	}
+	eight := lowRanks{0, 0, 0, 1, 1, 1, 1, 1} // 8-7-6-5-4
+	if got := lt.badnessToScore[lowBadness(&eight)]; got != Low8Min {
+		log.Fatalf("Expected 87654 to have low score %d, but found %d", Low8Min, got)
+	}
+	lt.tbl5 = genLowTable(5, lt.badnessToScore)
+	lt.tbl7 = genLowTable(7, lt.badnessToScore)
+	return lt
+}
+
+// evalLowSlow evaluates a 5- or 7-card ace-to-five lowball hand
+// without using the state machine.
+func evalLowSlow(c []Card) int16 {
+	var lr lowRanks
+	for _, ci := range c {
+		lr[ci>>2]++
+	}
+	return lowTbls().badnessToScore[bestLowBadness(&lr)]
+}
+
+// EvalLow5 evaluates a 5-card ace-to-five lowball hand, returning a
+// rank for the hand from 0 to LowScoreMax (inclusive).
+// Higher ranks are better low hands: 5-4-3-2-A has rank LowScoreMax.
+func EvalLow5(hand *[5]Card) int16 {
+	tbl := lowTbls().tbl5
+	idx := tbl[hand[0]>>2]
+	idx = tbl[idx+int32(hand[1]>>2)]
+	idx = tbl[idx+int32(hand[2]>>2)]
+	idx = tbl[idx+int32(hand[3]>>2)]
+	return int16(tbl[idx+int32(hand[4]>>2)])
+}
+
+// EvalLow7 evaluates the best 5-card ace-to-five lowball hand that can
+// be made from 7 cards, returning a rank for the hand from 0 to
+// LowScoreMax (inclusive). The ranks are comparable with those
+// returned by EvalLow5.
+func EvalLow7(hand *[7]Card) int16 {
+	tbl := lowTbls().tbl7
+	idx := tbl[hand[0]>>2]
+	idx = tbl[idx+int32(hand[1]>>2)]
+	idx = tbl[idx+int32(hand[2]>>2)]
+	idx = tbl[idx+int32(hand[3]>>2)]
+	idx = tbl[idx+int32(hand[4]>>2)]
+	idx = tbl[idx+int32(hand[5]>>2)]
+	return int16(tbl[idx+int32(hand[6]>>2)])
+}
+
+// QualifiesLow8 reports whether a lowball rank returned by EvalLow5 or
+// EvalLow7 is an 8-or-better low: five unpaired cards, all 8 or lower.
+func QualifiesLow8(low int16) bool {
+	return low >= Low8Min
+}
+
+// evalOmahaLow returns the best ace-to-five low hand that can be made
+// from exactly two of the hole cards and exactly three of the board
+// cards.
+func evalOmahaLow(hole []Card, board *[5]Card) int16 {
+	tbl := lowTbls().tbl5
+	best := int32(-1)
+	for i := 0; i < len(hole)-1; i++ {
+		si := tbl[hole[i]>>2]
+		for j := i + 1; j < len(hole); j++ {
+			sj := tbl[si+int32(hole[j]>>2)]
+			for a := 0; a < 3; a++ {
+				sa := tbl[sj+int32(board[a]>>2)]
+				for b := a + 1; b < 4; b++ {
+					sb := tbl[sa+int32(board[b]>>2)]
+					for c := b + 1; c < 5; c++ {
+						if ev := tbl[sb+int32(board[c]>>2)]; ev > best {
+							best = ev
+						}
+					}
+				}
+			}
+		}
+	}
+	return int16(best)
+}
+
+// EvalOmahaLow evaluates the ace-to-five low hand of an Omaha hand,
+// made from exactly two of the hole cards and exactly three of the
+// board cards. The ranks are comparable with those returned by EvalLow5.
+func EvalOmahaLow(hole *[4]Card, board *[5]Card) int16 {
+	return evalOmahaLow(hole[:], board)
+}
+
+// EvalOmahaLow5 is like EvalOmahaLow, but with 5 hole cards.
+func EvalOmahaLow5(hole *[5]Card, board *[5]Card) int16 {
+	return evalOmahaLow(hole[:], board)
+}
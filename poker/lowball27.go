@@ -0,0 +1,144 @@
+package poker
+
+import (
+	"fmt"
+	"log"
+	"sort"
+)
+
+// Score27Max is the largest possible rank of hand returned by the
+// deuce-to-seven lowball Eval functions.
+const Score27Max = 7461
+
+// In deuce-to-seven lowball, hands rank exactly as in high poker
+// but in reverse, except that aces are always high, so A-5-4-3-2 is
+// an ace-high hand rather than a straight. That means the deuce-to-seven
+// rank of a 5-card hand is determined by its high rank, and we can
+// compute it with a lookup table from the high rank.
+
+type eval27Infos struct {
+	// fromHigh maps a high rank from Eval5 to a deuce-to-seven rank.
+	fromHigh [ScoreMax + 1]int16
+	// wheel and wheelFlush are the deuce-to-seven ranks of A-5-4-3-2
+	// without and with a flush.
+	wheel, wheelFlush int16
+}
+
+var eval27Info = makeEval27Info()
+
+func makeEval27Info() *eval27Infos {
+	wheelStraight := evalScore5(4, 5, 0, 0, 0, 0).rank
+	wheelStraightFlush := evalScore5(8, 5, 0, 0, 0, 0).rank
+	aceHigh := evalScore5(0, 14, 5, 4, 3, 2).rank
+	aceHighFlush := evalScore5(5, 14, 5, 4, 3, 2).rank
+
+	fiveOfAKind := evalScore5(9, 0, 0, 0, 0, 0).rank
+
+	// Find the slow rank of each 5-card hand, treating wheels
+	// as ace-high hands. Five of a kind isn't possible with a
+	// single deck, so is ignored.
+	slow := map[int16]int{}
+	var ranks []int
+	for e := range evalInfo.rankTo5 {
+		h := evalInfo.rankTo5[e]
+		if len(h) == 0 {
+			continue
+		}
+		ev, err := evalSlow(h, true, false)
+		if err != nil {
+			log.Fatalf("failed to evaluate %v: %v", h, err)
+		}
+		switch {
+		case ev.rank >= fiveOfAKind:
+			continue
+		case ev.rank == wheelStraight:
+			ev.rank = aceHigh
+		case ev.rank == wheelStraightFlush:
+			ev.rank = aceHighFlush
+		}
+		slow[int16(e)] = ev.rank
+		ranks = append(ranks, ev.rank)
+	}
+	sort.Ints(ranks)
+	if Score27Max != len(ranks)-1 {
+		log.Fatalf("Expected max deuce-to-seven score of %d, but found %d", Score27Max, len(ranks)-1)
+	}
+	// The worst high hand is the best low hand.
+	packed := map[int]int16{}
+	for i, r := range ranks {
+		packed[r] = int16(Score27Max - i)
+	}
+	ei := &eval27Infos{
+		wheel:      packed[aceHigh],
+		wheelFlush: packed[aceHighFlush],
+	}
+	for e := range ei.fromHigh {
+		ei.fromHigh[e] = -1
+		if r, ok := slow[int16(e)]; ok {
+			ei.fromHigh[e] = packed[r]
+		}
+	}
+	return ei
+}
+
+// Eval27Low5 evaluates a 5-card deuce-to-seven lowball hand, returning
+// a rank for the hand from 0 to Score27Max (inclusive). Straights and
+// flushes count against the hand and aces are always high.
+// Higher ranks are better low hands: 7-5-4-3-2 has rank Score27Max.
+func Eval27Low5(hand *[5]Card) int16 {
+	return eval27Info.fromHigh[Eval5(hand)]
+}
+
+// Eval27Low7 evaluates the best 5-card deuce-to-seven lowball hand that
+// can be made from 7 cards, returning a rank for the hand from 0 to
+// Score27Max (inclusive). The ranks are comparable with those
+// returned by Eval27Low5.
+func Eval27Low7(hand *[7]Card) int16 {
+	best := int16(-1)
+	for a := 0; a < 3; a++ {
+		sa := eval5Start.next(hand[a])
+		for b := a + 1; b < 4; b++ {
+			sb := sa.next(hand[b])
+			for c := b + 1; c < 5; c++ {
+				sc := sb.next(hand[c])
+				for d := c + 1; d < 6; d++ {
+					sd := sc.next(hand[d])
+					for e := d + 1; e < 7; e++ {
+						if ev := eval27Info.fromHigh[sd.final(hand[e])]; ev > best {
+							best = ev
+						}
+					}
+				}
+			}
+		}
+	}
+	return best
+}
+
+// Describe27Low describes a 5 or 7 card deuce-to-seven lowball hand.
+// For 7 cards, it describes the best 5-card low hand. The descriptions
+// are the same as those from Describe, except for hands with the ranks
+// A-5-4-3-2, which are ace-high rather than straights.
+func Describe27Low(c []Card) (string, error) {
+	if _, err := MakeCardSet(c...); err != nil {
+		return "", fmt.Errorf("bad hand %v: %v", Hand(c), err)
+	}
+	var h5 [5]Card
+	switch len(c) {
+	case 5:
+		copy(h5[:], c)
+	case 7:
+		var h7 [7]Card
+		copy(h7[:], c)
+		h5, _ = bestFive(&h7, Eval27Low5, Eval27Low7(&h7))
+	default:
+		return "", fmt.Errorf("can't describe a %d card deuce-to-seven hand", len(c))
+	}
+	switch Eval27Low5(&h5) {
+	case eval27Info.wheel:
+		return evalScore("%s-%s-%s-%s-%s", 0, 14, 5, 4, 3, 2).desc, nil
+	case eval27Info.wheelFlush:
+		return evalScore("%s%s%s%s%s flush", 5, 14, 5, 4, 3, 2).desc, nil
+	}
+	return Describe(h5[:])
+}
@@ -0,0 +1,115 @@
+package poker
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRankings27(t *testing.T) {
+	// These hands are in descending order of strength as
+	// deuce-to-seven lowball hands.
+	hands := []string{
+		"S7 D5 H4 S3 S2",
+		"S7 D6 H4 S3 S2",
+		"S8 D5 H4 S3 S2",
+		"SK DQ HJ ST S8",
+		"SA D5 H4 S3 S2",
+		"SA DK HQ SJ S9",
+		"S2 D2 H3 S4 C5",
+		"SA DA HK SQ CJ",
+		"S2 D2 H3 S3 C4",
+		"S2 D2 H2 S3 C4",
+		"H6 D5 C4 S3 H2",
+		"SA DK HQ SJ CT",
+		"H7 H5 H4 H3 H2",
+		"HA H5 H4 H3 H2",
+		"SK HK DK C2 H2",
+		"SK HK DK CK H2",
+		"H6 H5 H4 H3 H2",
+		"HA HK HQ HJ HT",
+	}
+	prev := int16(Score27Max + 1)
+	prevHand := ""
+	for _, h := range hands {
+		c, err := parseHand(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var h5 [5]Card
+		copy(h5[:], c)
+		low := Eval27Low5(&h5)
+		if low >= prev {
+			t.Errorf("Expected %s to beat %s, but got scores %d and %d", prevHand, h, prev, low)
+		}
+		prev, prevHand = low, h
+	}
+	if prev != 0 {
+		t.Errorf("worst deuce-to-seven hand has score %d, want 0", prev)
+	}
+}
+
+func TestEval27Low7(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	for i := 0; i < 20000; i++ {
+		perm := rnd.Perm(52)
+		var h7 [7]Card
+		for j := range h7 {
+			h7[j] = Card(perm[j])
+		}
+		want := int16(-1)
+		for a := 0; a < 7; a++ {
+			for b := a + 1; b < 7; b++ {
+				var h5 [5]Card
+				k := 0
+				for j := 0; j < 7; j++ {
+					if j != a && j != b {
+						h5[k] = h7[j]
+						k++
+					}
+				}
+				if ev := Eval27Low5(&h5); ev > want {
+					want = ev
+				}
+			}
+		}
+		if got := Eval27Low7(&h7); got != want {
+			t.Errorf("Eval27Low7(%v) = %d, want %d", Hand(h7[:]), got, want)
+		}
+	}
+}
+
+func TestDescribe27Low(t *testing.T) {
+	tcs := []struct {
+		hand, want string
+	}{
+		{"S7 D5 H4 S3 S2", "7-5-4-3-2"},
+		{"S7 D5 H4 S3 S2 CK DK", "7-5-4-3-2"},
+		{"SA D5 H4 S3 S2", "A-5-4-3-2"},
+		{"HA H5 H4 H3 H2", "A5432 flush"},
+		{"H6 D5 C4 S3 H2", "6 straight"},
+		{"H6 D5 C4 S3 H2 SA D7", "7-5-4-3-2"},
+		{"H6 D5 C4 S3 H2 S6 C5", "55-4-3-2"},
+	}
+	for _, tc := range tcs {
+		c, err := parseHand(tc.hand)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := Describe27Low(c)
+		if err != nil {
+			t.Fatalf("Describe27Low(%s) failed: %v", tc.hand, err)
+		}
+		if got != tc.want {
+			t.Errorf("Describe27Low(%s) = %s, want %s", tc.hand, got, tc.want)
+		}
+	}
+	for _, hand := range []string{"S2 S2 D3 C4 H5", "S7 D5 H4 S3 S2 CK S7"} {
+		c, err := parseHand(hand)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, err := Describe27Low(c); err == nil {
+			t.Errorf("Describe27Low(%s) = %s, expected error for duplicate cards", hand, got)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package poker
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestLowRankings(t *testing.T) {
+	// These hands are in descending order of strength as
+	// ace-to-five lowball hands.
+	hands := []struct {
+		hand string
+		q8   bool
+	}{
+		{"H5 H4 H3 H2 HA", true},
+		{"C6 D4 H3 S2 CA", true},
+		{"C6 D5 H4 S3 C2", true},
+		{"C7 D5 H4 S3 C2", true},
+		{"C8 D6 H4 S3 C2", true},
+		{"C8 D7 H6 S5 C4", true},
+		{"C9 D2 H3 S4 C5", false},
+		{"CK DQ HJ ST C9", false},
+		{"CA DA H2 S3 C4", false},
+		{"C2 D2 HA S3 C4", false},
+		{"CK DK HQ SJ CT", false},
+		{"CA DA H2 S2 C3", false},
+		{"CK DK HQ SQ CJ", false},
+		{"CA DA HA S2 C3", false},
+		{"C2 D2 H2 SA C3", false},
+		{"CA DA HA S2 C2", false},
+		{"CK DK HK SQ CQ", false},
+		{"CA DA HA SA C2", false},
+		{"CK DK HK SK CQ", false},
+	}
+	prev := int16(LowScoreMax + 1)
+	prevHand := ""
+	for _, h := range hands {
+		c, err := parseHand(h.hand)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var h5 [5]Card
+		copy(h5[:], c)
+		low := EvalLow5(&h5)
+		if low >= prev {
+			t.Errorf("Expected %s to beat %s, but got low scores %d and %d", prevHand, h.hand, prev, low)
+		}
+		if QualifiesLow8(low) != h.q8 {
+			t.Errorf("QualifiesLow8(%s) = %v, want %v", h.hand, QualifiesLow8(low), h.q8)
+		}
+		prev, prevHand = low, h.hand
+	}
+	if prev != 0 {
+		t.Errorf("worst low hand has score %d, want 0", prev)
+	}
+}
+
+func TestEvalLow7(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	for i := 0; i < 20000; i++ {
+		perm := rnd.Perm(52)
+		var h7 [7]Card
+		for j := range h7 {
+			h7[j] = Card(perm[j])
+		}
+		var h5 [5]Card
+		copy(h5[:], h7[:])
+		if got, want := EvalLow5(&h5), evalLowSlow(h5[:]); got != want {
+			t.Errorf("EvalLow5(%v) = %d, want %d", Hand(h5[:]), got, want)
+		}
+		// The best low of 7 cards is the best low of any 5 of them.
+		want := int16(-1)
+		for a := 0; a < 7; a++ {
+			for b := a + 1; b < 7; b++ {
+				k := 0
+				for j := 0; j < 7; j++ {
+					if j != a && j != b {
+						h5[k] = h7[j]
+						k++
+					}
+				}
+				if ev := EvalLow5(&h5); ev > want {
+					want = ev
+				}
+			}
+		}
+		if got := EvalLow7(&h7); got != want {
+			t.Errorf("EvalLow7(%v) = %d, want %d", Hand(h7[:]), got, want)
+		}
+	}
+}
+
+func TestEvalLow7Single(t *testing.T) {
+	tcs := []struct {
+		hand, best string
+	}{
+		{"CK DK HA S2 C2 D3 H7", "H7 D3 C2 HA CK"},
+		{"CA DA HA S2 C2 D2 H3", "HA S2 H3 CA C2"},
+		{"C8 D7 H6 S5 C4 D3 H2", "D3 H2 S5 C4 H6"},
+	}
+	for _, tc := range tcs {
+		c, err := parseHand(tc.hand)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := parseHand(tc.best)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var h7 [7]Card
+		var h5 [5]Card
+		copy(h7[:], c)
+		copy(h5[:], b)
+		if got, want := EvalLow7(&h7), EvalLow5(&h5); got != want {
+			t.Errorf("EvalLow7(%s) = %d, want %d (%s)", tc.hand, got, want, tc.best)
+		}
+	}
+}
+
+func BenchmarkEvalLow7(b *testing.B) {
+	rnd := rand.New(rand.NewSource(42))
+	const N = 1024
+	hands := make([][7]Card, N)
+	for i := 0; i < N; i++ {
+		perm := rnd.Perm(52)
+		for j := 0; j < 7; j++ {
+			hands[i][j] = Card(perm[j])
+		}
+	}
+	b.ResetTimer()
+	var T int
+	for n := 0; n < b.N; n++ {
+		T += int(EvalLow7(&hands[n%N]))
+	}
+	// make sure we're not optimizing the code away.
+	if T < 0 {
+		panic("x")
+	}
+}
+
+func BenchmarkLowTables(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		makeLowTables()
+	}
+}
@@ -0,0 +1,93 @@
+package poker
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Cards, hands, suits and ranks implement encoding.TextMarshaler and
+// encoding.TextUnmarshaler, so that they can be used in JSON, YAML
+// and flags in their conventional rank-first forms. For example, a
+// Hand marshals as "Ah Kd". Unmarshaling accepts any of the forms
+// accepted by ParseCard and ParseHand.
+
+// MarshalText returns the card in rank-first form, for example Ah.
+func (c Card) MarshalText() ([]byte, error) {
+	if !c.Valid() {
+		return nil, fmt.Errorf("can't marshal invalid card %d", c)
+	}
+	return []byte(c.RankFirst()), nil
+}
+
+// UnmarshalText parses a card in any form accepted by ParseCard.
+func (c *Card) UnmarshalText(text []byte) error {
+	pc, err := ParseCard(string(text))
+	if err != nil {
+		return err
+	}
+	*c = pc
+	return nil
+}
+
+// MarshalText returns the cards in the hand in rank-first form,
+// separated by spaces, for example "Ah Kd".
+func (h Hand) MarshalText() ([]byte, error) {
+	parts := make([]string, len(h))
+	for i, c := range h {
+		b, err := c.MarshalText()
+		if err != nil {
+			return nil, err
+		}
+		parts[i] = string(b)
+	}
+	return []byte(strings.Join(parts, " ")), nil
+}
+
+// UnmarshalText parses a hand in any form accepted by ParseHand.
+func (h *Hand) UnmarshalText(text []byte) error {
+	ph, err := ParseHand(string(text))
+	if err != nil {
+		return err
+	}
+	*h = ph
+	return nil
+}
+
+// MarshalText returns the suit as a single lower-case letter: c, d, h or s.
+func (s Suit) MarshalText() ([]byte, error) {
+	if s > Spade {
+		return nil, fmt.Errorf("can't marshal invalid suit %d", s)
+	}
+	return []byte(strings.ToLower(s.String())), nil
+}
+
+// UnmarshalText parses a suit, which is one of CDHS in upper or lower
+// case, or a unicode suit symbol like ♥.
+func (s *Suit) UnmarshalText(text []byte) error {
+	ps, n, ok := parseSuitPrefix(string(text))
+	if !ok || n != len(text) {
+		return fmt.Errorf("can't parse suit %q", text)
+	}
+	*s = ps
+	return nil
+}
+
+// MarshalText returns the rank as a single character, one of
+// A23456789TJQK.
+func (r Rank) MarshalText() ([]byte, error) {
+	if r < 1 || r > 13 {
+		return nil, fmt.Errorf("can't marshal invalid rank %d", r)
+	}
+	return []byte(r.String()), nil
+}
+
+// UnmarshalText parses a rank, which is one of A23456789TJQK in upper
+// or lower case, or 10.
+func (r *Rank) UnmarshalText(text []byte) error {
+	pr, n, ok := parseRankPrefix(string(text))
+	if !ok || n != len(text) {
+		return fmt.Errorf("can't parse rank %q", text)
+	}
+	*r = pr
+	return nil
+}
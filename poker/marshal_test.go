@@ -0,0 +1,68 @@
+package poker
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	type showdown struct {
+		Hands  []Hand   `json:"hands"`
+		Hole   [2]Card  `json:"hole"`
+		Suit   Suit     `json:"suit"`
+		Rank   Rank     `json:"rank"`
+		Equity []Equity `json:"equity"`
+	}
+	hands := parseHands(t, "HA DK", "ST S9")
+	in := showdown{
+		Hands:  hands,
+		Hole:   [2]Card{hands[0][0], hands[0][1]},
+		Suit:   Heart,
+		Rank:   10,
+		Equity: []Equity{{Equity: 0.75, Win: 0.5, Tie: 0.5, Boards: 2}},
+	}
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"hands":["Ah Kd","Ts 9s"],"hole":["Ah","Kd"],"suit":"h","rank":"T","equity":[{"equity":0.75,"win":0.5,"tie":0.5,"boards":2}]}`
+	if string(b) != want {
+		t.Errorf("got %s, want %s", b, want)
+	}
+	var out showdown
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(out, in) {
+		t.Errorf("round-trip got %+v, want %+v", out, in)
+	}
+}
+
+func TestUnmarshalText(t *testing.T) {
+	var c Card
+	if err := json.Unmarshal([]byte(`"10♥"`), &c); err != nil || c.String() != "HT" {
+		t.Errorf("got %s, %v, want HT", c, err)
+	}
+	var h Hand
+	if err := json.Unmarshal([]byte(`"AhAh"`), &h); err == nil {
+		t.Errorf("got %s, expected error for duplicate cards", h)
+	}
+	var s Suit
+	if err := s.UnmarshalText([]byte("♠")); err != nil || s != Spade {
+		t.Errorf("got %s, %v, want S", s, err)
+	}
+	if err := s.UnmarshalText([]byte("sx")); err == nil {
+		t.Errorf("expected error for bad suit")
+	}
+	var r Rank
+	if err := r.UnmarshalText([]byte("10")); err != nil || r != 10 {
+		t.Errorf("got %s, %v, want T", r, err)
+	}
+	if err := r.UnmarshalText([]byte("1")); err == nil {
+		t.Errorf("expected error for bad rank")
+	}
+	if _, err := Card(52).MarshalText(); err == nil {
+		t.Errorf("expected error marshaling invalid card")
+	}
+}
@@ -0,0 +1,98 @@
+package poker
+
+// eval5State is a partial walk through the 5-card evaluation
+// state machine: the index of the current node in rootNode5table, and
+// the suit transform to apply to subsequent cards.
+// It allows hands that share cards to share the cost of walking the
+// state machine.
+type eval5State struct {
+	idx int
+	tx  suitTransformByte
+}
+
+var eval5Start = eval5State{tx: suitTransformByteIdentity}
+
+// next returns the state after adding card c.
+func (s eval5State) next(c Card) eval5State {
+	v := rootNode5table[s.idx+int(s.tx.Apply(c))]
+	return eval5State{idx: int(v >> 8), tx: s.tx.Compose(suitTransformByte(v))}
+}
+
+// final returns the rank of the hand formed by adding c, which
+// must be the fifth card.
+func (s eval5State) final(c Card) int16 {
+	return int16(rootNode5table[s.idx+int(s.tx.Apply(c))])
+}
+
+// evalOmaha returns the best rank of a hand made from exactly two
+// of the hole cards and exactly three of the board cards.
+func evalOmaha(hole []Card, board *[5]Card) int16 {
+	var best int16
+	for i := 0; i < len(hole)-1; i++ {
+		si := eval5Start.next(hole[i])
+		for j := i + 1; j < len(hole); j++ {
+			sj := si.next(hole[j])
+			for a := 0; a < 3; a++ {
+				sa := sj.next(board[a])
+				for b := a + 1; b < 4; b++ {
+					sb := sa.next(board[b])
+					for c := b + 1; c < 5; c++ {
+						if ev := sb.final(board[c]); ev > best {
+							best = ev
+						}
+					}
+				}
+			}
+		}
+	}
+	return best
+}
+
+// EvalOmaha evaluates an Omaha hand, returning a rank for the hand from
+// 0 to ScoreMax (inclusive). The hand is the best that can be made
+// from exactly two of the hole cards and exactly three of the board
+// cards. The ranks are comparable with those returned by Eval5.
+func EvalOmaha(hole *[4]Card, board *[5]Card) int16 {
+	return evalOmaha(hole[:], board)
+}
+
+// EvalOmaha5 evaluates a 5-card Omaha hand. It is like EvalOmaha, but
+// with 5 hole cards.
+func EvalOmaha5(hole *[5]Card, board *[5]Card) int16 {
+	return evalOmaha(hole[:], board)
+}
+
+// EvalOmaha6 evaluates a 6-card Omaha hand. It is like EvalOmaha, but
+// with 6 hole cards.
+func EvalOmaha6(hole *[6]Card, board *[5]Card) int16 {
+	return evalOmaha(hole[:], board)
+}
+
+func omahaRiverEquities(hands [][4]Card, board *[5]Card, evs []int16, ec *equityCounts) {
+	for i := range hands {
+		evs[i] = EvalOmaha(&hands[i], board)
+	}
+	ec.add(evs)
+}
+
+// OmahaEquities returns the river equities for the given Omaha hands
+// given a board of up to 5 cards.
+// The hands and board must be distinct, and the board can't have more
+// than 5 cards in it.
+func OmahaEquities(hands [][4]Card, board []Card) ([]Equity, error) {
+	hs := make([]Hand, len(hands))
+	for i := range hands {
+		hs[i] = hands[i][:]
+	}
+	deck, err := remainingDeck(hs, board)
+	if err != nil {
+		return nil, err
+	}
+
+	ec := newEquityCounts(len(hands))
+	evs := make([]int16, len(hands))
+	T := forEachRunout(deck, board, func(brd *[5]Card) {
+		omahaRiverEquities(hands, brd, evs, ec)
+	})
+	return ec.equities(T), nil
+}
@@ -0,0 +1,213 @@
+package poker
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// omahaSlow evaluates an omaha hand by trying every combination
+// of two hole cards and three board cards.
+func omahaSlow(hole []Card, board []Card) int16 {
+	var best int16
+	for i := 0; i < len(hole); i++ {
+		for j := i + 1; j < len(hole); j++ {
+			for a := 0; a < 5; a++ {
+				for b := a + 1; b < 5; b++ {
+					for c := b + 1; c < 5; c++ {
+						ev := EvalSlow([]Card{hole[i], hole[j], board[a], board[b], board[c]})
+						if ev > best {
+							best = ev
+						}
+					}
+				}
+			}
+		}
+	}
+	return best
+}
+
+func TestEvalOmahaSingle(t *testing.T) {
+	tcs := []struct {
+		hole, board string
+		want        string
+	}{
+		// Four hearts on the board don't make a flush with one heart.
+		{hole: "HA SA DK CK", board: "H2 H7 H9 HJ C3", want: "AA-J-9-7"},
+		// A single hole card can't play: no straight here.
+		{hole: "SA D2 C2 H2", board: "SK SQ SJ ST D9", want: "22-K-Q-J"},
+		// But two suited hole cards make a straight flush.
+		{hole: "S9 S8 C2 H2", board: "SQ SJ ST D3 D4", want: "Q straight flush"},
+		// Trips on the board must play with two hole cards.
+		{hole: "HA DA C3 D4", board: "S7 H7 D7 CK SQ", want: "777-AA"},
+	}
+	for _, tc := range tcs {
+		hole, err := parseHand(tc.hole)
+		if err != nil {
+			t.Fatal(err)
+		}
+		board, err := parseHand(tc.board)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var h [4]Card
+		var b [5]Card
+		copy(h[:], hole)
+		copy(b[:], board)
+		got := EvalOmaha(&h, &b)
+		ex, ok := EvalToHand5(got)
+		if !ok {
+			t.Fatalf("EvalOmaha(%s, %s) = %d, which isn't a 5-card rank", tc.hole, tc.board, got)
+		}
+		desc, err := Describe(ex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if desc != tc.want {
+			t.Errorf("EvalOmaha(%s, %s) is %s, want %s", tc.hole, tc.board, desc, tc.want)
+		}
+	}
+}
+
+func TestEvalOmaha(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	for i := 0; i < 5000; i++ {
+		perm := rnd.Perm(52)
+		var cards [11]Card
+		for j := range cards {
+			cards[j] = Card(perm[j])
+		}
+		var board [5]Card
+		copy(board[:], cards[6:])
+
+		var h4 [4]Card
+		copy(h4[:], cards[:4])
+		if got, want := EvalOmaha(&h4, &board), omahaSlow(h4[:], board[:]); got != want {
+			t.Errorf("EvalOmaha(%v, %v) = %d, want %d", Hand(h4[:]), Hand(board[:]), got, want)
+		}
+		var h5 [5]Card
+		copy(h5[:], cards[:5])
+		if got, want := EvalOmaha5(&h5, &board), omahaSlow(h5[:], board[:]); got != want {
+			t.Errorf("EvalOmaha5(%v, %v) = %d, want %d", Hand(h5[:]), Hand(board[:]), got, want)
+		}
+		var h6 [6]Card
+		copy(h6[:], cards[:6])
+		if got, want := EvalOmaha6(&h6, &board), omahaSlow(h6[:], board[:]); got != want {
+			t.Errorf("EvalOmaha6(%v, %v) = %d, want %d", Hand(h6[:]), Hand(board[:]), got, want)
+		}
+	}
+}
+
+func TestOmahaEquities(t *testing.T) {
+	hand := func(s string) [4]Card {
+		h, err := parseHand(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var r [4]Card
+		copy(r[:], h)
+		return r
+	}
+	board, err := parseHand("HA D9 S5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hands := [][4]Card{hand("SA CA HK HQ"), hand("S8 S7 D6 C6"), hand("HJ DJ ST CT")}
+	eqs, err := OmahaEquities(hands, board)
+	if err != nil {
+		t.Fatalf("failed to compute equities: %v", err)
+	}
+
+	// Compute the same equities more slowly.
+	hs := make([]Hand, len(hands))
+	for i := range hands {
+		hs[i] = hands[i][:]
+	}
+	deck, err := remainingDeck(hs, board)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ec := newEquityCounts(len(hands))
+	T := 0
+	for i := 0; i < len(deck); i++ {
+		for j := i + 1; j < len(deck); j++ {
+			T++
+			b := append(append([]Card{}, board...), deck[i], deck[j])
+			evs := make([]int16, len(hands))
+			for k := range hands {
+				evs[k] = omahaSlow(hands[k][:], b)
+			}
+			ec.add(evs)
+		}
+	}
+	want := ec.equities(T)
+
+	for i := range hands {
+		if eqs[i].Boards != T {
+			t.Errorf("hand %v: got %d boards, want %d", Hand(hands[i][:]), eqs[i].Boards, T)
+		}
+		if math.Abs(eqs[i].Equity-want[i].Equity) > 1e-9 {
+			t.Errorf("hand %v: equity=%f, want %f", Hand(hands[i][:]), eqs[i].Equity, want[i].Equity)
+		}
+		if math.Abs(eqs[i].Win-want[i].Win) > 1e-9 {
+			t.Errorf("hand %v: win=%f, want %f", Hand(hands[i][:]), eqs[i].Win, want[i].Win)
+		}
+		if math.Abs(eqs[i].Tie-want[i].Tie) > 1e-9 {
+			t.Errorf("hand %v: tie=%f, want %f", Hand(hands[i][:]), eqs[i].Tie, want[i].Tie)
+		}
+	}
+}
+
+func TestOmahaEquitiesRiver(t *testing.T) {
+	var hands [][4]Card
+	for _, s := range []string{"SA CA HK HQ", "S8 S7 D6 C6"} {
+		h, err := parseHand(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var r [4]Card
+		copy(r[:], h)
+		hands = append(hands, r)
+	}
+	// The board has four to a straight, but the first hand can only
+	// make trip aces, and the second hand makes the 9-high straight.
+	board, err := parseHand("HA D9 S5 C8 H7")
+	if err != nil {
+		t.Fatal(err)
+	}
+	eqs, err := OmahaEquities(hands, board)
+	if err != nil {
+		t.Fatalf("failed to compute equities: %v", err)
+	}
+	want := []Equity{{Boards: 1}, {Equity: 1, Win: 1, Boards: 1}}
+	for i := range want {
+		if eqs[i] != want[i] {
+			t.Errorf("hand %v: got %+v, want %+v", Hand(hands[i][:]), eqs[i], want[i])
+		}
+	}
+}
+
+func BenchmarkEvalOmaha(b *testing.B) {
+	rnd := rand.New(rand.NewSource(42))
+	const N = 1024
+	holes := make([][4]Card, N)
+	boards := make([][5]Card, N)
+	for i := 0; i < N; i++ {
+		perm := rnd.Perm(52)
+		for j := 0; j < 4; j++ {
+			holes[i][j] = Card(perm[j])
+		}
+		for j := 0; j < 5; j++ {
+			boards[i][j] = Card(perm[4+j])
+		}
+	}
+	b.ResetTimer()
+	var T int
+	for n := 0; n < b.N; n++ {
+		T += int(EvalOmaha(&holes[n%N], &boards[n%N]))
+	}
+	// make sure we're not optimizing the code away.
+	if T < 0 {
+		panic("x")
+	}
+}
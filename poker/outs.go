@@ -0,0 +1,120 @@
+package poker
+
+import "fmt"
+
+// A CardOutcome is the result of a card being dealt next.
+type CardOutcome struct {
+	Card Card
+	// Leaders is the players with the best hand after the card is
+	// dealt. There's more than one if they split the pot.
+	Leaders []int
+}
+
+// Outs are the cards that help a player who isn't ahead outright.
+type Outs struct {
+	// Clean is the cards that put the player ahead outright.
+	Clean []Card
+	// Split is the cards that tie the player for the lead, if they're
+	// currently behind.
+	Split []Card
+	// Dirty is the cards that give the player a better hand than
+	// the current best hand, but give someone else an even better
+	// hand, for example when a card makes the player's flush and
+	// also pairs the board for an opponent's full house.
+	Dirty []Card
+}
+
+// OutsResult is the result of HoldemOuts.
+type OutsResult struct {
+	// Leaders is the players with the best hand on the given board.
+	Leaders []int
+	// Cards is the outcome of each card that could be dealt next,
+	// in the same order as Cards.
+	Cards []CardOutcome
+	// Outs is the outs of each player. A player who's ahead outright
+	// on the given board has no outs.
+	Outs []Outs
+}
+
+// leaders returns the indexes of the highest evaluations.
+func leaders(evs []int16) []int {
+	var r []int
+	best := int16(-1)
+	for i, ev := range evs {
+		if ev > best {
+			r, best = r[:0], ev
+		}
+		if ev == best {
+			r = append(r, i)
+		}
+	}
+	return r
+}
+
+// HoldemOuts finds the outs of each of the given holdem hands on a
+// flop or turn board of 3 or 4 cards: for each card that could be
+// dealt next, which players it puts in the lead. The dead cards are
+// cards known to be out of play, which can't be dealt next. The hands,
+// board and dead cards must be distinct.
+func HoldemOuts(hands [][2]Card, board []Card, dead []Card) (*OutsResult, error) {
+	if len(board) != 3 && len(board) != 4 {
+		return nil, fmt.Errorf("board %s must have 3 or 4 cards", boardString(board))
+	}
+	if len(hands) == 0 {
+		return nil, fmt.Errorf("no hands given")
+	}
+	deck, err := getRemainingDeck(hands, board)
+	if err != nil {
+		return nil, err
+	}
+	if deck, err = removeDeadCards(deck, dead, 1); err != nil {
+		return nil, err
+	}
+
+	bs, _ := MakeCardSet(board...)
+	hs := make([]CardSet, len(hands))
+	evs := make([]int16, len(hands))
+	for i, h := range hands {
+		hs[i] = bs.Add(h[0]).Add(h[1])
+		evs[i] = EvalSet(hs[i])
+	}
+	r := &OutsResult{
+		Leaders: leaders(evs),
+		Outs:    make([]Outs, len(hands)),
+	}
+	ahead := -1
+	if len(r.Leaders) == 1 {
+		ahead = r.Leaders[0]
+	}
+	best := evs[r.Leaders[0]]
+	behind := make([]bool, len(hands))
+	for i := range behind {
+		behind[i] = evs[i] < best
+	}
+
+	for _, c := range deck {
+		for i := range hands {
+			evs[i] = EvalSet(hs[i].Add(c))
+		}
+		ls := leaders(evs)
+		r.Cards = append(r.Cards, CardOutcome{Card: c, Leaders: ls})
+		for i := range hands {
+			if i == ahead {
+				continue
+			}
+			lead := false
+			for _, l := range ls {
+				lead = lead || l == i
+			}
+			switch {
+			case lead && len(ls) == 1:
+				r.Outs[i].Clean = append(r.Outs[i].Clean, c)
+			case lead && behind[i]:
+				r.Outs[i].Split = append(r.Outs[i].Split, c)
+			case !lead && evs[i] > best:
+				r.Outs[i].Dirty = append(r.Outs[i].Dirty, c)
+			}
+		}
+	}
+	return r, nil
+}
@@ -0,0 +1,103 @@
+package poker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHoldemOuts(t *testing.T) {
+	cards := func(s string) []Card {
+		if s == "" {
+			return nil
+		}
+		return parseHands(t, s)[0]
+	}
+	sets := func(o Outs) [3]CardSet {
+		var r [3]CardSet
+		for i, cs := range [][]Card{o.Clean, o.Split, o.Dirty} {
+			r[i], _ = MakeCardSet(cs...)
+		}
+		return r
+	}
+	tcs := []struct {
+		name    string
+		hands   []string
+		board   string
+		leaders []int
+		outs    []Outs
+	}{
+		{
+			// Any heart makes the flush, but the 2h also makes a full
+			// house for the set.
+			name:    "flush draw vs set on the flop",
+			hands:   []string{"HK HQ", "SA CA"},
+			board:   "HA H7 C2",
+			leaders: []int{1},
+			outs: []Outs{
+				{
+					Clean: cards("H3 H4 H5 H6 H8 H9 HT HJ"),
+					Dirty: cards("H2"),
+				},
+				{},
+			},
+		},
+		{
+			// A queen wins, and a straight on the board splits the
+			// pot. An ace or pairing the board helps both hands, and
+			// a king gives the ace-queen a better kicker than the
+			// ace-king had, but also pairs the king.
+			name:    "dominated ace on the turn",
+			hands:   []string{"SA DK", "CA DQ"},
+			board:   "S5 H6 D7 C8",
+			leaders: []int{0},
+			outs: []Outs{
+				{},
+				{
+					Clean: cards("CQ HQ SQ"),
+					Split: cards("C4 D4 H4 S4 C9 D9 H9 S9"),
+					Dirty: cards("DA HA CK HK SK C5 D5 H5 C6 D6 S6 C7 H7 S7 D8 H8 S8"),
+				},
+			},
+		},
+	}
+	for _, tc := range tcs {
+		hands := holdemHands(parseHands(t, tc.hands...))
+		board := cards(tc.board)
+		r, err := HoldemOuts(hands, board, nil)
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if !reflect.DeepEqual(r.Leaders, tc.leaders) {
+			t.Errorf("%s: got leaders %v, want %v", tc.name, r.Leaders, tc.leaders)
+		}
+		for i, o := range r.Outs {
+			if got, want := sets(o), sets(tc.outs[i]); got != want {
+				t.Errorf("%s: player %d got outs %v, want %v", tc.name, i, got, want)
+			}
+		}
+		if want := 52 - 2*len(hands) - len(board); len(r.Cards) != want {
+			t.Errorf("%s: got %d cards, want %d", tc.name, len(r.Cards), want)
+		}
+	}
+
+	hands := holdemHands(parseHands(t, "HK HQ", "SA CA"))
+	board := cards("HA H7 C2")
+	r, err := HoldemOuts(hands, board, cards("H3 H4"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Cards) != 43 || len(r.Outs[0].Clean) != 6 {
+		t.Errorf("with 2 dead hearts, got %d cards and clean outs %v", len(r.Cards), r.Outs[0].Clean)
+	}
+	for _, c := range r.Cards {
+		if c.Card == NameToCard["H2"] && !reflect.DeepEqual(c.Leaders, []int{1}) {
+			t.Errorf("H2 has leaders %v, want [1]", c.Leaders)
+		}
+	}
+	if _, err := HoldemOuts(hands, cards("HA H7 C2 D3 D4"), nil); err == nil {
+		t.Errorf("expected error for river board")
+	}
+	if _, err := HoldemOuts(nil, board, nil); err == nil {
+		t.Errorf("expected error for no hands")
+	}
+}
@@ -0,0 +1,137 @@
+package poker
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// suitRunes maps the characters that can be used for a suit when
+// parsing cards, to the suit.
+var suitRunes = map[rune]Suit{
+	'c': Club, 'C': Club, '♣': Club, '♧': Club,
+	'd': Diamond, 'D': Diamond, '♦': Diamond, '♢': Diamond,
+	'h': Heart, 'H': Heart, '♥': Heart, '♡': Heart,
+	's': Spade, 'S': Spade, '♠': Spade, '♤': Spade,
+}
+
+// parseRankPrefix parses a rank at the start of s, returning the rank
+// and the number of bytes used.
+func parseRankPrefix(s string) (Rank, int, bool) {
+	if strings.HasPrefix(s, "10") {
+		return 10, 2, true
+	}
+	if s == "" {
+		return 0, 0, false
+	}
+	i := strings.IndexByte("A23456789TJQK", byte(unicode.ToUpper(rune(s[0]))))
+	if i < 0 {
+		return 0, 0, false
+	}
+	return Rank(i + 1), 1, true
+}
+
+// parseSuitPrefix parses a suit at the start of s, returning the suit
+// and the number of bytes used.
+func parseSuitPrefix(s string) (Suit, int, bool) {
+	r, n := utf8.DecodeRuneInString(s)
+	st, ok := suitRunes[r]
+	return st, n, ok
+}
+
+// parseCardPrefix parses a card at the start of s, returning the card
+// and the rest of the string.
+func parseCardPrefix(s string) (Card, string, error) {
+	// Rank first, like Ah.
+	if r, n, ok := parseRankPrefix(s); ok {
+		if st, m, ok := parseSuitPrefix(s[n:]); ok {
+			return mustMakeCard(st, r), s[n+m:], nil
+		}
+	}
+	// Suit first, like HA.
+	if st, n, ok := parseSuitPrefix(s); ok {
+		if r, m, ok := parseRankPrefix(s[n:]); ok {
+			return mustMakeCard(st, r), s[n+m:], nil
+		}
+	}
+	end := len(s)
+	if i := strings.IndexFunc(s, isCardSeparator); i >= 0 {
+		end = i
+	}
+	return 0, "", fmt.Errorf("can't parse card %q: expected a rank and suit like Ah, HA, A♥ or 10h", s[:end])
+}
+
+func isCardSeparator(r rune) bool {
+	return r == ',' || unicode.IsSpace(r)
+}
+
+// ParseCard parses a single card. The rank can come before or after
+// the suit, and either can be in upper or lower case. The rank is one
+// of A23456789TJQK, and ten can also be written as 10. The suit is one
+// of CDHS, or a unicode suit symbol like ♥.
+// For example, "Ah", "ah", "HA", "A♥" and "10h" are all valid cards.
+func ParseCard(s string) (Card, error) {
+	c, rest, err := parseCardPrefix(strings.TrimSpace(s))
+	if err != nil {
+		return 0, err
+	}
+	if rest != "" {
+		return 0, fmt.Errorf("unexpected %q after card in %q", rest, s)
+	}
+	return c, nil
+}
+
+// ParseHand parses a hand of cards, in any of the forms accepted by
+// ParseCard. The cards can be separated by spaces or commas, or not
+// separated at all, for example "AhKh", "Ah Kh" or "A♥,K♥".
+// It returns an error if a card appears more than once.
+func ParseHand(s string) (Hand, error) {
+	var h Hand
+	var seen CardSet
+	for {
+		s = strings.TrimLeftFunc(s, isCardSeparator)
+		if s == "" {
+			return h, nil
+		}
+		c, rest, err := parseCardPrefix(s)
+		if err != nil {
+			return nil, err
+		}
+		if seen.Contains(c) {
+			return nil, fmt.Errorf("card %s appears more than once", c.RankFirst())
+		}
+		seen = seen.Add(c)
+		h = append(h, c)
+		s = rest
+	}
+}
+
+// ParseBoard parses a holdem or omaha board of up to 5 cards, in the
+// same format as ParseHand.
+func ParseBoard(s string) ([]Card, error) {
+	h, err := ParseHand(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(h) > 5 {
+		return nil, fmt.Errorf("board %q has more than 5 (%d) cards", s, len(h))
+	}
+	return h, nil
+}
+
+// RankFirst returns the conventional string form of a card, with the
+// rank followed by the suit in lower case, for example Ah or Tc.
+func (c Card) RankFirst() string {
+	return c.Rank().String() + strings.ToLower(c.Suit().String())
+}
+
+// RankFirst returns the conventional string form of a hand, with each
+// card written rank first and no separators, for example AhKh.
+func (h Hand) RankFirst() string {
+	var sb strings.Builder
+	for _, c := range h {
+		sb.WriteString(c.RankFirst())
+	}
+	return sb.String()
+}
@@ -0,0 +1,91 @@
+package poker
+
+import (
+	"testing"
+)
+
+func TestParseCard(t *testing.T) {
+	tcs := []struct {
+		s    string
+		want string
+	}{
+		{"Ah", "HA"},
+		{"ah", "HA"},
+		{"AH", "HA"},
+		{"HA", "HA"},
+		{"ha", "HA"},
+		{"A♥", "HA"},
+		{"♥A", "HA"},
+		{"A♡", "HA"},
+		{"10s", "ST"},
+		{"S10", "ST"},
+		{"Ts", "ST"},
+		{"st", "ST"},
+		{"2c", "C2"},
+		{"K♠", "SK"},
+		{"Q♦", "DQ"},
+		{"J♣", "CJ"},
+		{" 9d ", "D9"},
+	}
+	for _, tc := range tcs {
+		c, err := ParseCard(tc.s)
+		if err != nil {
+			t.Errorf("ParseCard(%q) failed: %v", tc.s, err)
+			continue
+		}
+		if c.String() != tc.want {
+			t.Errorf("ParseCard(%q) = %s, want %s", tc.s, c, tc.want)
+		}
+	}
+	for _, s := range []string{"", "A", "h", "Ax", "1h", "11h", "AhK", "Ah Kh", "xx"} {
+		if c, err := ParseCard(s); err == nil {
+			t.Errorf("ParseCard(%q) = %s, expected error", s, c)
+		}
+	}
+}
+
+func TestParseHand(t *testing.T) {
+	tcs := []struct {
+		s    string
+		want string
+	}{
+		{"AhKh", "AhKh"},
+		{"Ah Kh", "AhKh"},
+		{"HA,HK", "AhKh"},
+		{"A♥, K♥ 10♥", "AhKhTh"},
+		{"10h10s", "ThTs"},
+		{"7d8c8s", "7d8c8s"},
+		{"", ""},
+	}
+	for _, tc := range tcs {
+		h, err := ParseHand(tc.s)
+		if err != nil {
+			t.Errorf("ParseHand(%q) failed: %v", tc.s, err)
+			continue
+		}
+		if got := h.RankFirst(); got != tc.want {
+			t.Errorf("ParseHand(%q) = %s, want %s", tc.s, got, tc.want)
+		}
+	}
+	for _, s := range []string{"AhAh", "Ah Kx", "AhK"} {
+		if h, err := ParseHand(s); err == nil {
+			t.Errorf("ParseHand(%q) = %s, expected error", s, h)
+		}
+	}
+	if b, err := ParseBoard("2c3c4c5c6c7c"); err == nil {
+		t.Errorf("ParseBoard of 6 cards = %s, expected error", Hand(b))
+	}
+}
+
+func TestRankFirstRoundTrip(t *testing.T) {
+	for _, c := range Cards {
+		got, err := ParseCard(c.RankFirst())
+		if err != nil || got != c {
+			t.Errorf("ParseCard(%q) = %s, %v, want %s", c.RankFirst(), got, err, c)
+		}
+		got, err = ParseCard(c.String())
+		if err != nil || got != c {
+			t.Errorf("ParseCard(%q) = %s, %v, want %s", c.String(), got, err, c)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package poker
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 	"strings"
 	"testing"
@@ -20,6 +21,34 @@ func parseHand(s string) ([]Card, error) {
 	return r, nil
 }
 
+func parseHands(t testing.TB, ss ...string) []Hand {
+	var hs []Hand
+	for _, s := range ss {
+		h, err := parseHand(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hs = append(hs, h)
+	}
+	return hs
+}
+
+func holdemHands(hs []Hand) [][2]Card {
+	r := make([][2]Card, len(hs))
+	for i, h := range hs {
+		copy(r[i][:], h)
+	}
+	return r
+}
+
+func equityClose(a, b Equity) bool {
+	const eps = 1e-12
+	return a.Boards == b.Boards &&
+		math.Abs(a.Equity-b.Equity) < eps &&
+		math.Abs(a.Win-b.Win) < eps &&
+		math.Abs(a.Tie-b.Tie) < eps
+}
+
 func TestDescriptions(t *testing.T) {
 	// Hands and their long and short descriptions.
 	// When the short description is expected to be the same as the long,
@@ -0,0 +1,238 @@
+package poker
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Preflop heads-up equities are precomputed by gen_preflop.go, and
+// stored in preflopData. Matchups that are the same up to a
+// permutation of suits (and swapping the hands) have the same
+// equities, so only one of each is stored, using the key
+// returned by preflopKey.
+
+// preflopBoards is the number of runouts of a heads-up preflop matchup.
+const preflopBoards = 48 * 47 * 46 * 45 * 44 / 120
+
+// allSuitPerms is every permutation of the four suits.
+var allSuitPerms = makeSuitPerms()
+
+func makeSuitPerms() []suitTransform {
+	var r []suitTransform
+	for a := uint8(0); a < 4; a++ {
+		for b := uint8(0); b < 4; b++ {
+			for c := uint8(0); c < 4; c++ {
+				d := 6 - a - b - c
+				if a == b || a == c || b == c || d > 3 || d == a || d == b || d == c {
+					continue
+				}
+				r = append(r, suitTransform{a, b, c, d})
+			}
+		}
+	}
+	return r
+}
+
+// preflopKey returns the key of a heads-up matchup: the smallest
+// encoding of the hands over all permutations of suits and both
+// orders of the hands. swapped reports whether the second hand is
+// the first one in the key.
+func preflopKey(h1, h2 [2]Card) (key uint32, swapped bool) {
+	enc := func(a, b [2]Card) uint32 {
+		if a[0] < a[1] {
+			a[0], a[1] = a[1], a[0]
+		}
+		if b[0] < b[1] {
+			b[0], b[1] = b[1], b[0]
+		}
+		return uint32(a[0])<<24 | uint32(a[1])<<16 | uint32(b[0])<<8 | uint32(b[1])
+	}
+	key = ^uint32(0)
+	for _, st := range allSuitPerms {
+		a := [2]Card{st.Apply(h1[0]), st.Apply(h1[1])}
+		b := [2]Card{st.Apply(h2[0]), st.Apply(h2[1])}
+		if k := enc(a, b); k < key {
+			key, swapped = k, false
+		}
+		if k := enc(b, a); k < key {
+			key, swapped = k, true
+		}
+	}
+	return key, swapped
+}
+
+// InternalPreflopMatchups returns one example of each heads-up
+// preflop matchup that's distinct up to suits, and their keys in
+// increasing order. It's used by gen_preflop.go to generate the
+// preflop equity data, and is subject to change.
+func InternalPreflopMatchups() (keys []uint32, hands [][2][2]Card) {
+	seen := map[uint32]bool{}
+	for i, a := range Cards {
+		for _, b := range Cards[i+1:] {
+			for j, c := range Cards {
+				for _, d := range Cards[j+1:] {
+					if c == a || c == b || d == a || d == b {
+						continue
+					}
+					k, _ := preflopKey([2]Card{a, b}, [2]Card{c, d})
+					seen[k] = true
+				}
+			}
+		}
+	}
+	for k := range seen {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	for _, k := range keys {
+		hands = append(hands, [2][2]Card{
+			{Card(k >> 24), Card(k >> 16)},
+			{Card(k >> 8), Card(k)},
+		})
+	}
+	return keys, hands
+}
+
+type preflopTable struct {
+	keys []uint32
+	// wins and ties are the number of runouts on which the first hand
+	// in the key wins or ties.
+	wins, ties []uint32
+}
+
+var (
+	preflopTbl     *preflopTable
+	preflopTblInit sync.Once
+)
+
+// preflopEquities returns the table of preflop equities, decoding it
+// on first use.
+func preflopEquities() *preflopTable {
+	preflopTblInit.Do(func() {
+		t, err := decodePreflopData(preflopData)
+		if err != nil {
+			panic(err)
+		}
+		preflopTbl = t
+	})
+	return preflopTbl
+}
+
+// decodePreflopData decodes data written by gen_preflop.go. It's
+// base64-encoded gzipped data: the number of matchups, and then for
+// each, the difference between its key and the previous key, the
+// wins and the ties, all as uvarints.
+func decodePreflopData(data string) (*preflopTable, error) {
+	zr, err := gzip.NewReader(base64.NewDecoder(base64.RawStdEncoding, strings.NewReader(data)))
+	if err != nil {
+		return nil, err
+	}
+	r := bufio.NewReader(zr)
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	t := &preflopTable{
+		keys: make([]uint32, n),
+		wins: make([]uint32, n),
+		ties: make([]uint32, n),
+	}
+	var key uint64
+	for i := range t.keys {
+		var vs [3]uint64
+		for j := range vs {
+			if vs[j], err = binary.ReadUvarint(r); err != nil {
+				return nil, fmt.Errorf("failed to read preflop matchup %d: %v", i, err)
+			}
+		}
+		key += vs[0]
+		t.keys[i], t.wins[i], t.ties[i] = uint32(key), uint32(vs[1]), uint32(vs[2])
+	}
+	return t, zr.Close()
+}
+
+// PreflopEquity returns the preflop all-in equities of two holdem
+// hands against each other, from precomputed data.
+func PreflopEquity(h1, h2 [2]Card) ([2]Equity, error) {
+	if _, err := getRemainingDeck([][2]Card{h1, h2}, nil); err != nil {
+		return [2]Equity{}, err
+	}
+	key, swapped := preflopKey(h1, h2)
+	t := preflopEquities()
+	i := sort.Search(len(t.keys), func(i int) bool { return t.keys[i] >= key })
+	if i == len(t.keys) || t.keys[i] != key {
+		return [2]Equity{}, fmt.Errorf("no preflop data for %v vs %v", Hand(h1[:]), Hand(h2[:]))
+	}
+	wins, ties := int(t.wins[i]), int(t.ties[i])
+	losses := preflopBoards - wins - ties
+	if swapped {
+		wins, losses = losses, wins
+	}
+	tie := float64(ties) / preflopBoards
+	return [2]Equity{
+		{
+			Equity: (float64(wins) + float64(ties)/2) / preflopBoards,
+			Win:    float64(wins) / preflopBoards,
+			Tie:    tie,
+			Boards: preflopBoards,
+		},
+		{
+			Equity: (float64(losses) + float64(ties)/2) / preflopBoards,
+			Win:    float64(losses) / preflopBoards,
+			Tie:    tie,
+			Boards: preflopBoards,
+		},
+	}, nil
+}
+
+// PreflopClassEquity returns the preflop all-in equities of two
+// classes of holdem starting hands against each other, like "AKs"
+// and "QQ", from precomputed data. The classes are written as in
+// ParseRange, with AK meaning both AKs and AKo. The equities are
+// averaged over every pair of combos from the classes that don't
+// share a card, and Boards is the total number of runouts.
+func PreflopClassEquity(c1, c2 string) ([2]Equity, error) {
+	rc1, err := parseRangeClass(c1)
+	if err != nil {
+		return [2]Equity{}, err
+	}
+	rc2, err := parseRangeClass(c2)
+	if err != nil {
+		return [2]Equity{}, err
+	}
+	var r [2]Equity
+	n := 0
+	for _, a := range rc1.combos() {
+		for _, b := range rc2.combos() {
+			if a[0] == b[0] || a[0] == b[1] || a[1] == b[0] || a[1] == b[1] {
+				continue
+			}
+			eqs, err := PreflopEquity(a, b)
+			if err != nil {
+				return [2]Equity{}, err
+			}
+			for i := range r {
+				r[i].Equity += eqs[i].Equity
+				r[i].Win += eqs[i].Win
+				r[i].Tie += eqs[i].Tie
+				r[i].Boards += eqs[i].Boards
+			}
+			n++
+		}
+	}
+	if n == 0 {
+		return [2]Equity{}, fmt.Errorf("every %s shares a card with every %s", c1, c2)
+	}
+	for i := range r {
+		r[i].Equity /= float64(n)
+		r[i].Win /= float64(n)
+		r[i].Tie /= float64(n)
+	}
+	return r, nil
+}
@@ -0,0 +1,63 @@
+package poker
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestPreflopEquity(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	for i := 0; i < 20; i++ {
+		var hands [2][2]Card
+		for j, p := range rnd.Perm(52)[:4] {
+			hands[j/2][j%2] = Cards[p]
+		}
+		want, err := HoldemEquities(hands[:], nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := PreflopEquity(hands[0], hands[1])
+		if err != nil {
+			t.Fatalf("PreflopEquity(%v, %v) failed: %v", hands[0], hands[1], err)
+		}
+		for j := range got {
+			if !equityClose(got[j], want[j]) {
+				t.Errorf("PreflopEquity(%v, %v)[%d] = %+v, want %+v", hands[0], hands[1], j, got[j], want[j])
+			}
+		}
+	}
+	h := holdemHands(parseHands(t, "SA HA", "HA DK"))
+	if _, err := PreflopEquity(h[0], h[1]); err == nil {
+		t.Errorf("expected error for hands sharing a card")
+	}
+}
+
+func TestPreflopClassEquity(t *testing.T) {
+	tcs := []struct {
+		c1, c2 string
+		min    float64
+		max    float64
+	}{
+		{"AA", "KK", 0.81, 0.83},
+		{"KK", "AA", 0.17, 0.19},
+		{"AKo", "22", 0.45, 0.48},
+		{"AKs", "AKo", 0.5, 0.54},
+		{"AA", "AA", 0.5, 0.5},
+	}
+	for _, tc := range tcs {
+		eqs, err := PreflopClassEquity(tc.c1, tc.c2)
+		if err != nil {
+			t.Fatalf("PreflopClassEquity(%s, %s) failed: %v", tc.c1, tc.c2, err)
+		}
+		if eqs[0].Equity < tc.min || eqs[0].Equity > tc.max {
+			t.Errorf("PreflopClassEquity(%s, %s) = %f, want between %f and %f", tc.c1, tc.c2, eqs[0].Equity, tc.min, tc.max)
+		}
+		if math.Abs(eqs[0].Equity+eqs[1].Equity-1) > 1e-9 {
+			t.Errorf("PreflopClassEquity(%s, %s) equities add up to %f", tc.c1, tc.c2, eqs[0].Equity+eqs[1].Equity)
+		}
+	}
+	if _, err := PreflopClassEquity("AAs", "KK"); err == nil {
+		t.Errorf("expected error for bad class")
+	}
+}
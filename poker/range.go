@@ -0,0 +1,676 @@
+package poker
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// A Combo is a specific pair of holdem hole cards in a range, with
+// a weight. The weight is the fraction of the time the combo is in
+// the range, from 0 to 1.
+type Combo struct {
+	Cards  [2]Card
+	Weight float64
+}
+
+// A Range is a set of holdem hole cards, each with a weight. A Range
+// returned by ParseRange has each combo only once, with its cards
+// ordered highest rank first, and sorted from the strongest starting
+// hand class to the weakest.
+type Range []Combo
+
+// Ranks from the deuce (0) to the ace (12), matching Card.RawRank.
+const rangeRanks = "23456789TJQKA"
+
+// rawRankToRank converts a raw rank (2->0, ..., A->12) to a Rank.
+func rawRankToRank(r int) Rank {
+	return Rank((r+1)%13 + 1)
+}
+
+// comboCards orders a pair of cards so that the higher rank comes
+// first, or the higher suit for pairs.
+func comboCards(a, b Card) [2]Card {
+	if a.RawRank() < b.RawRank() || a.RawRank() == b.RawRank() && a.Suit() < b.Suit() {
+		a, b = b, a
+	}
+	return [2]Card{a, b}
+}
+
+// rangeClass is one of the 169 classes of holdem starting hands, for
+// example AKs. hi and lo are raw ranks, and kind is 's' for suited,
+// 'o' for offsuit, or 0 for both (or for pairs).
+type rangeClass struct {
+	hi, lo int
+	kind   byte
+}
+
+func (rc rangeClass) String() string {
+	s := rangeRanks[rc.hi:rc.hi+1] + rangeRanks[rc.lo:rc.lo+1]
+	if rc.kind != 0 {
+		s += string(rc.kind)
+	}
+	return s
+}
+
+// combos returns all the combos of cards in the class.
+func (rc rangeClass) combos() [][2]Card {
+	var r [][2]Card
+	for s1 := Suit(0); s1 <= Spade; s1++ {
+		for s2 := Suit(0); s2 <= Spade; s2++ {
+			if rc.hi == rc.lo && s2 <= s1 ||
+				rc.kind == 's' && s1 != s2 ||
+				rc.kind == 'o' && s1 == s2 {
+				continue
+			}
+			a := mustMakeCard(s1, rawRankToRank(rc.hi))
+			b := mustMakeCard(s2, rawRankToRank(rc.lo))
+			r = append(r, comboCards(a, b))
+		}
+	}
+	return r
+}
+
+func parseRangeRank(b byte) (int, bool) {
+	i := strings.IndexByte(rangeRanks, b)
+	if i < 0 {
+		i = strings.IndexByte(rangeRanks, b-'a'+'A')
+	}
+	return i, i >= 0
+}
+
+func parseRangeClass(s string) (rangeClass, error) {
+	if len(s) != 2 && len(s) != 3 {
+		return rangeClass{}, fmt.Errorf("bad hand class %q", s)
+	}
+	hi, ok1 := parseRangeRank(s[0])
+	lo, ok2 := parseRangeRank(s[1])
+	if !ok1 || !ok2 {
+		return rangeClass{}, fmt.Errorf("bad ranks in hand class %q", s)
+	}
+	if hi < lo {
+		hi, lo = lo, hi
+	}
+	rc := rangeClass{hi: hi, lo: lo}
+	if len(s) == 3 {
+		switch s[2] {
+		case 's', 'S':
+			rc.kind = 's'
+		case 'o', 'O':
+			rc.kind = 'o'
+		default:
+			return rangeClass{}, fmt.Errorf("bad suitedness in hand class %q: should be s or o", s)
+		}
+		if hi == lo {
+			return rangeClass{}, fmt.Errorf("pair %q can't be suited or offsuit", s)
+		}
+	}
+	return rc, nil
+}
+
+func parseRangeSuit(b byte) (Suit, bool) {
+	switch b {
+	case 'c', 'C':
+		return Club, true
+	case 'd', 'D':
+		return Diamond, true
+	case 'h', 'H':
+		return Heart, true
+	case 's', 'S':
+		return Spade, true
+	}
+	return BadSuit, false
+}
+
+// parseRangeCombo parses a specific combo like AhKh.
+func parseRangeCombo(s string) ([2]Card, bool) {
+	var cs [2]Card
+	if len(s) != 4 {
+		return cs, false
+	}
+	for i := range cs {
+		r, ok1 := parseRangeRank(s[2*i])
+		st, ok2 := parseRangeSuit(s[2*i+1])
+		if !ok1 || !ok2 {
+			return cs, false
+		}
+		cs[i] = mustMakeCard(st, rawRankToRank(r))
+	}
+	return comboCards(cs[0], cs[1]), cs[0] != cs[1]
+}
+
+// expandRangeTerm returns the hand classes described by a single range
+// term without a weight, for example "TT+", "A5s-A2s" or "KQo".
+func expandRangeTerm(s string) ([]rangeClass, error) {
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		a, err := parseRangeClass(s[:i])
+		if err != nil {
+			return nil, err
+		}
+		b, err := parseRangeClass(s[i+1:])
+		if err != nil {
+			return nil, err
+		}
+		if a.kind != b.kind || (a.hi == a.lo) != (b.hi == b.lo) {
+			return nil, fmt.Errorf("range %q has ends of different types", s)
+		}
+		if a.hi < b.hi || a.hi == b.hi && a.lo < b.lo {
+			a, b = b, a
+		}
+		var r []rangeClass
+		switch {
+		case a.hi == a.lo:
+			// A range of pairs, like 99-66.
+			for x := b.hi; x <= a.hi; x++ {
+				r = append(r, rangeClass{hi: x, lo: x})
+			}
+		case a.hi == b.hi:
+			// A range of kickers, like A5s-A2s.
+			for x := b.lo; x <= a.lo; x++ {
+				r = append(r, rangeClass{hi: a.hi, lo: x, kind: a.kind})
+			}
+		case a.hi-a.lo == b.hi-b.lo:
+			// A range with the same gap, like 76s-43s.
+			for x := b.hi; x <= a.hi; x++ {
+				r = append(r, rangeClass{hi: x, lo: x - (a.hi - a.lo), kind: a.kind})
+			}
+		default:
+			return nil, fmt.Errorf("range %q should have the same high card or the same gap at each end", s)
+		}
+		return r, nil
+	}
+	plus := strings.HasSuffix(s, "+")
+	rc, err := parseRangeClass(strings.TrimSuffix(s, "+"))
+	if err != nil {
+		return nil, err
+	}
+	if !plus {
+		return []rangeClass{rc}, nil
+	}
+	var r []rangeClass
+	switch {
+	case rc.hi == rc.lo:
+		// Pairs: TT+ is TT, JJ, QQ, KK, AA.
+		for x := rc.hi; x < 13; x++ {
+			r = append(r, rangeClass{hi: x, lo: x})
+		}
+	case rc.hi-rc.lo == 1:
+		// Connectors: 76s+ is 76s, 87s, ..., AKs.
+		for x := rc.hi; x < 13; x++ {
+			r = append(r, rangeClass{hi: x, lo: x - 1, kind: rc.kind})
+		}
+	default:
+		// Kickers: A9s+ is A9s, ATs, ..., AKs.
+		for x := rc.lo; x < rc.hi; x++ {
+			r = append(r, rangeClass{hi: rc.hi, lo: x, kind: rc.kind})
+		}
+	}
+	return r, nil
+}
+
+// ParseRange parses a holdem range written in standard notation: a
+// list of terms separated by commas or spaces. Terms can be:
+//
+//	a pair, suited or offsuit hand, or both: TT, AKs, AKo, AK
+//	a hand and all better hands of the same type: TT+, A9s+
+//	  (for connectors, both ranks increase: 76s+ is 76s to AKs)
+//	a range with the same high card or gap at each end: A5s-A2s, 99-66, 76s-43s
+//	a specific combo: AhKh
+//
+// Any term can be followed by a colon and a weight from 0 to 1, for
+// example AKo:0.5. If a combo appears more than once, the last weight
+// given is used. Combos with weight 0 are omitted.
+func ParseRange(s string) (Range, error) {
+	weights := map[[2]Card]float64{}
+	terms := strings.FieldsFunc(s, func(r rune) bool {
+		return r == ',' || r == ' ' || r == '\t' || r == '\n'
+	})
+	for _, term := range terms {
+		w := 1.0
+		if i := strings.IndexByte(term, ':'); i >= 0 {
+			var err error
+			w, err = strconv.ParseFloat(term[i+1:], 64)
+			if err != nil || w < 0 || w > 1 {
+				return nil, fmt.Errorf("bad weight in range term %q: should be a number from 0 to 1", term)
+			}
+			term = term[:i]
+		}
+		if c, ok := parseRangeCombo(term); ok {
+			weights[c] = w
+			continue
+		}
+		rcs, err := expandRangeTerm(term)
+		if err != nil {
+			return nil, err
+		}
+		for _, rc := range rcs {
+			for _, c := range rc.combos() {
+				weights[c] = w
+			}
+		}
+	}
+	var r Range
+	for c, w := range weights {
+		if w > 0 {
+			r = append(r, Combo{Cards: c, Weight: w})
+		}
+	}
+	sort.Slice(r, func(i, j int) bool {
+		return comboLess(r[i].Cards, r[j].Cards)
+	})
+	return r, nil
+}
+
+// comboLess orders combos by their hand class, with pairs first, and
+// then by suits.
+func comboLess(a, b [2]Card) bool {
+	ap, bp := a[0].RawRank() == a[1].RawRank(), b[0].RawRank() == b[1].RawRank()
+	if ap != bp {
+		return ap
+	}
+	for i := 0; i < 2; i++ {
+		if a[i].RawRank() != b[i].RawRank() {
+			return a[i].RawRank() > b[i].RawRank()
+		}
+	}
+	if as, bs := a[0].Suit() == a[1].Suit(), b[0].Suit() == b[1].Suit(); as != bs {
+		return as
+	}
+	if a[0] != b[0] {
+		return a[0] > b[0]
+	}
+	return a[1] > b[1]
+}
+
+// String returns the range in standard notation, compressed using
+// + and - where possible. Combos are grouped by weight.
+func (r Range) String() string {
+	byWeight := map[float64]map[[2]Card]bool{}
+	var ws []float64
+	for _, c := range r {
+		if c.Weight <= 0 {
+			continue
+		}
+		if byWeight[c.Weight] == nil {
+			byWeight[c.Weight] = map[[2]Card]bool{}
+			ws = append(ws, c.Weight)
+		}
+		byWeight[c.Weight][comboCards(c.Cards[0], c.Cards[1])] = true
+	}
+	sort.Sort(sort.Reverse(sort.Float64Slice(ws)))
+	var parts []string
+	for _, w := range ws {
+		suffix := ""
+		if w != 1 {
+			suffix = ":" + strconv.FormatFloat(w, 'g', -1, 64)
+		}
+		for _, t := range compressCombos(byWeight[w]) {
+			parts = append(parts, t+suffix)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// compressCombos returns range terms that describe exactly the
+// given set of combos.
+func compressCombos(set map[[2]Card]bool) []string {
+	// full reports whether every combo in the class is in the set.
+	full := func(rc rangeClass) bool {
+		for _, c := range rc.combos() {
+			if !set[c] {
+				return false
+			}
+		}
+		return true
+	}
+	covered := map[[2]Card]bool{}
+	// uncovered counts the classes that have combos that aren't
+	// covered by previous terms.
+	uncovered := func(rcs []rangeClass) int {
+		n := 0
+		for _, rc := range rcs {
+			for _, c := range rc.combos() {
+				if !covered[c] {
+					n++
+					break
+				}
+			}
+		}
+		return n
+	}
+
+	var terms []string
+	// runs adds terms for runs of full classes, where class(x) is
+	// the class at position x in a line of classes from the weakest
+	// (x=0) to the strongest (x=n-1). Only runs of at least minLen
+	// classes, with at least minUncovered classes not already covered,
+	// are added. A run that reaches the strongest class is written
+	// with a +, and other runs with a -.
+	runs := func(n int, class func(x int) rangeClass, minLen, minUncovered int) {
+		for x := n - 1; x >= 0; {
+			if !full(class(x)) {
+				x--
+				continue
+			}
+			y := x
+			for y > 0 && full(class(y-1)) {
+				y--
+			}
+			var rcs []rangeClass
+			for z := y; z <= x; z++ {
+				rcs = append(rcs, class(z))
+			}
+			if len(rcs) >= minLen && uncovered(rcs) >= minUncovered {
+				switch {
+				case x == y:
+					terms = append(terms, class(x).String())
+				case x == n-1:
+					terms = append(terms, class(y).String()+"+")
+				default:
+					terms = append(terms, class(x).String()+"-"+class(y).String())
+				}
+				for _, rc := range rcs {
+					for _, c := range rc.combos() {
+						covered[c] = true
+					}
+				}
+			}
+			x = y - 1
+		}
+	}
+	kinds := []byte{0, 's', 'o'}
+	// rows adds runs of suited and offsuit hands with the same high
+	// card. When a run of suited hands matches a run of offsuit hands,
+	// they're written together without the s or o.
+	rows := func(minLen int) {
+		for hi := 12; hi > 0; hi-- {
+			for _, kind := range kinds {
+				hi, kind := hi, kind
+				runs(hi, func(x int) rangeClass { return rangeClass{hi: hi, lo: x, kind: kind} }, minLen, 1)
+			}
+		}
+	}
+
+	// Pairs.
+	runs(13, func(x int) rangeClass { return rangeClass{hi: x, lo: x} }, 1, 1)
+	// Longer runs with the same high card, like A9s+.
+	rows(2)
+	// Runs of connectors, like 76s+ or 76s-43s, that cover at least
+	// two classes not already covered.
+	for _, kind := range kinds {
+		kind := kind
+		runs(12, func(x int) rangeClass { return rangeClass{hi: x + 1, lo: x, kind: kind} }, 2, 2)
+	}
+	// Everything else with the same high card.
+	rows(1)
+
+	// Remaining combos are written individually.
+	var rest [][2]Card
+	for c := range set {
+		if !covered[c] {
+			rest = append(rest, c)
+		}
+	}
+	sort.Slice(rest, func(i, j int) bool { return comboLess(rest[i], rest[j]) })
+	for _, c := range rest {
+		terms = append(terms, Hand(c[:]).RankFirst())
+	}
+	return terms
+}
+
+// checkRanges checks that the board is valid, and that every combo
+// in the ranges is made of two different valid cards with a
+// non-negative weight.
+func checkRanges(ranges []Range, board []Card) error {
+	if _, err := remainingDeck(nil, board); err != nil {
+		return err
+	}
+	if len(ranges) == 0 {
+		return fmt.Errorf("no ranges given")
+	}
+	for i, r := range ranges {
+		for _, c := range r {
+			if !c.Cards[0].Valid() || !c.Cards[1].Valid() || c.Cards[0] == c.Cards[1] {
+				return fmt.Errorf("range %d contains bad combo %v", i, Hand(c.Cards[:]))
+			}
+			if c.Weight < 0 {
+				return fmt.Errorf("range %d has negative weight %f for combo %s", i, c.Weight, Hand(c.Cards[:]).RankFirst())
+			}
+		}
+	}
+	return nil
+}
+
+// FullRange returns the range of every holdem hand, each with weight
+// 1. It's the range of a player whose hand is unknown.
+func FullRange() Range {
+	var r Range
+	for i, a := range Cards {
+		for _, b := range Cards[i+1:] {
+			r = append(r, Combo{Cards: comboCards(a, b), Weight: 1})
+		}
+	}
+	sort.Slice(r, func(i, j int) bool {
+		return comboLess(r[i].Cards, r[j].Cards)
+	})
+	return r
+}
+
+// HandRange returns the range containing just the given hand, with
+// weight 1. It's the range of a player whose hand is known.
+func HandRange(h [2]Card) Range {
+	return Range{{Cards: comboCards(h[0], h[1]), Weight: 1}}
+}
+
+// RangeEquities returns the river equities for players holding hands
+// from the given ranges, given a board of up to 5 cards. Every
+// combination of one combo from each range is considered, except those
+// that share cards with each other or the board, and the equities
+// for each combination are weighted by the product of the combos'
+// weights. Boards is the total number of runouts evaluated.
+// Players with known hands can be given a range from HandRange, and
+// players with unknown hands a range from FullRange.
+// Exact evaluation is expensive when there are many combinations and
+// few board cards, and RangeEquitiesSampled may be used instead.
+func RangeEquities(ranges []Range, board []Card) ([]Equity, error) {
+	return RangeEquitiesWithOptions(ranges, board, EquityOptions{})
+}
+
+// RangeEquitiesWithOptions is like RangeEquities, but allows the
+// calculation to be configured. Combos containing dead cards are
+// skipped, and Workers applies to evaluating the runouts of each
+// combination of hands. Progress is called after each combination of
+// hands is evaluated, with the number of combinations evaluated so
+// far and the total number of combinations. If Categories is set,
+// the category counts are summed over every runout evaluated, without
+// weighting by the combos' weights.
+// Each combination of hands is evaluated as by HoldemEquities, so the
+// cost is roughly the product of the ranges' sizes times the cost of
+// HoldemEquities. For example, a known hand against two full ranges
+// takes most of a minute on the flop, and hours preflop.
+func RangeEquitiesWithOptions(ranges []Range, board []Card, opts EquityOptions) ([]Equity, error) {
+	if err := checkRanges(ranges, board); err != nil {
+		return nil, err
+	}
+	deck, _ := remainingDeck(nil, board)
+	if _, err := removeDeadCards(deck, opts.Dead, 5-len(board)); err != nil {
+		return nil, err
+	}
+	out, _ := MakeCardSet(append(append([]Card(nil), board...), opts.Dead...)...)
+	// Heads-up preflop equities can be found in the precomputed table,
+	// but only if there are no dead cards, and categories aren't needed.
+	preflop := len(board) == 0 && len(ranges) == 2 && len(opts.Dead) == 0 && !opts.Categories
+	hopts := EquityOptions{Workers: opts.Workers, Dead: opts.Dead, Categories: opts.Categories}
+
+	hands := make([][2]Card, len(ranges))
+	// deal picks a combo for player i onwards, and calls f for each
+	// combination of hands with the product of their weights.
+	var deal func(i int, used CardSet, w float64, f func(w float64) error) error
+	deal = func(i int, used CardSet, w float64, f func(w float64) error) error {
+		if i == len(ranges) {
+			return f(w)
+		}
+		for _, c := range ranges[i] {
+			m := CardSet(0).Add(c.Cards[0]).Add(c.Cards[1])
+			if c.Weight == 0 || used.Intersect(m) != 0 {
+				continue
+			}
+			hands[i] = c.Cards
+			if err := deal(i+1, used.Union(m), w*c.Weight, f); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	count := 0
+	deal(0, out, 1, func(float64) error {
+		count++
+		return nil
+	})
+	if count == 0 {
+		return nil, fmt.Errorf("the ranges have no combinations of hands that don't share cards")
+	}
+
+	eqs := make([]Equity, len(ranges))
+	if opts.Categories {
+		for i := range eqs {
+			eqs[i].Categories = &CategoryCounts{}
+		}
+	}
+	var total float64
+	done := 0
+	err := deal(0, out, 1, func(w float64) error {
+		var heqs []Equity
+		if preflop {
+			eqs, err := PreflopEquity(hands[0], hands[1])
+			if err != nil {
+				return err
+			}
+			heqs = eqs[:]
+		} else {
+			var err error
+			if heqs, err = HoldemEquitiesWithOptions(hands, board, hopts); err != nil {
+				return err
+			}
+		}
+		total += w
+		for j, e := range heqs {
+			eqs[j].Equity += w * e.Equity
+			eqs[j].Win += w * e.Win
+			eqs[j].Tie += w * e.Tie
+			eqs[j].Boards += e.Boards
+			if e.Categories != nil {
+				eqs[j].Categories.add(e.Categories)
+			}
+		}
+		done++
+		if opts.Progress != nil {
+			opts.Progress(done, count)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range eqs {
+		eqs[i].Equity /= total
+		eqs[i].Win /= total
+		eqs[i].Tie /= total
+	}
+	return eqs, nil
+}
+
+// maxRangeRejections is how many times in a row RangeEquitiesSampled
+// can pick combos that share cards before giving up.
+const maxRangeRejections = 100000
+
+// RangeEquitiesSampled estimates the river equities for players
+// holding hands from the given ranges, given a board of up to 5 cards.
+// Each sample picks a combo from each range with probability
+// proportional to its weight, rejecting picks that share cards with
+// each other or the board, and then picks a random runout.
+func RangeEquitiesSampled(ranges []Range, board []Card, opts SampleOptions) ([]EquityEstimate, error) {
+	if err := opts.check(); err != nil {
+		return nil, err
+	}
+	if err := checkRanges(ranges, board); err != nil {
+		return nil, err
+	}
+	deck, _ := remainingDeck(nil, board)
+	if _, err := removeDeadCards(deck, opts.Dead, 5-len(board)); err != nil {
+		return nil, err
+	}
+	rnd := opts.rand()
+	// out is the cards on the board or dead, which can't be in
+	// anyone's hand.
+	out, _ := MakeCardSet(append(append([]Card(nil), board...), opts.Dead...)...)
+	// cumulative weights of each range, for picking combos.
+	cums := make([][]float64, len(ranges))
+	for i, r := range ranges {
+		var sum float64
+		for _, c := range r {
+			sum += c.Weight
+			cums[i] = append(cums[i], sum)
+		}
+		if sum == 0 {
+			return nil, fmt.Errorf("range %d is empty", i)
+		}
+	}
+
+	ec := newEquityCounts(len(ranges)).trackCategories(opts.Categories)
+	evs := make([]int16, len(ranges))
+	hbs := make([][7]Card, len(ranges))
+	deck = make([]Card, 0, 52)
+	k := 5 - len(board)
+	T := 0
+	for rejections := 0; ; {
+		used := out
+		ok := true
+		for i, cum := range cums {
+			x := rnd.Float64() * cum[len(cum)-1]
+			j := sort.Search(len(cum), func(j int) bool { return cum[j] > x })
+			if j == len(cum) {
+				j--
+			}
+			c := ranges[i][j].Cards
+			m := CardSet(0).Add(c[0]).Add(c[1])
+			if used.Intersect(m) != 0 {
+				ok = false
+				break
+			}
+			used = used.Union(m)
+			hbs[i][0], hbs[i][1] = c[0], c[1]
+		}
+		if !ok {
+			rejections++
+			if rejections >= maxRangeRejections {
+				return nil, fmt.Errorf("failed to find combinations of hands from the ranges that don't share cards")
+			}
+			continue
+		}
+		rejections = 0
+
+		deck = deck[:0]
+		FullCardSet.Intersect(^used).ForEach(func(c Card) {
+			deck = append(deck, c)
+		})
+		if len(deck) < k {
+			return nil, fmt.Errorf("only %d cards are left after dealing the hands, but %d are needed", len(deck), k)
+		}
+		for j := 0; j < k; j++ {
+			r := j + rnd.Intn(len(deck)-j)
+			deck[j], deck[r] = deck[r], deck[j]
+		}
+		for i := range hbs {
+			copy(hbs[i][2:], board)
+			copy(hbs[i][2+len(board):], deck[:k])
+		}
+		holdemRiverEquities(hbs, evs, ec)
+		T++
+		if opts.done(ec, T) {
+			break
+		}
+	}
+	return ec.estimates(T), nil
+}
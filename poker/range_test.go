@@ -0,0 +1,291 @@
+package poker
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestParseRange(t *testing.T) {
+	tcs := []struct {
+		r          string
+		wantCombos int
+		wantString string
+	}{
+		{"AA", 6, "AA"},
+		{"TT+", 30, "TT+"},
+		{"99-66", 24, "99-66"},
+		{"AKs", 4, "AKs"},
+		{"AKo", 12, "AKo"},
+		{"AK", 16, "AK"},
+		{"ka", 16, "AK"},
+		{"A5s-A2s", 16, "A5s-A2s"},
+		{"A2s-A5s", 16, "A5s-A2s"},
+		{"A9s+", 20, "A9s+"},
+		{"76s+", 32, "76s+"},
+		{"76s-43s", 16, "76s-43s"},
+		{"KQo", 12, "KQo"},
+		{"AhKh", 1, "AhKh"},
+		{"KhAh", 1, "AhKh"},
+		{"TT+, AKs, A5s-A2s, KQo, 76s+", 30 + 16 + 12 + 32, "TT+, A5s-A2s, 76s+, KQ"},
+		{"ATs+ AQo+", 16 + 24, "AQ+, ATs+"},
+		{"AK:0.5, QQ", 22, "QQ, AK:0.5"},
+		{"AK, AKo:0", 4, "AKs"},
+		{"AA, AhAd:0.25", 6, "AsAh, AsAd, AsAc, AhAc, AdAc, AhAd:0.25"},
+		{"", 0, ""},
+	}
+	for _, tc := range tcs {
+		r, err := ParseRange(tc.r)
+		if err != nil {
+			t.Errorf("ParseRange(%q) failed: %v", tc.r, err)
+			continue
+		}
+		if len(r) != tc.wantCombos {
+			t.Errorf("ParseRange(%q) has %d combos, want %d", tc.r, len(r), tc.wantCombos)
+		}
+		if got := r.String(); got != tc.wantString {
+			t.Errorf("ParseRange(%q).String() = %q, want %q", tc.r, got, tc.wantString)
+		}
+		// The string form should parse back to the same range.
+		r2, err := ParseRange(r.String())
+		if err != nil {
+			t.Errorf("ParseRange(%q) failed: %v", r.String(), err)
+			continue
+		}
+		if r2.String() != r.String() || len(r2) != len(r) {
+			t.Errorf("%q doesn't round-trip: got %q", r.String(), r2.String())
+		}
+	}
+}
+
+func TestParseRangeErrors(t *testing.T) {
+	for _, s := range []string{"AAs", "AKx", "AK+:2", "AK:x", "AKs-QJo", "A5s-K2s", "ZZ", "AKQ", "AhAh", "TT-A5s"} {
+		if r, err := ParseRange(s); err == nil {
+			t.Errorf("ParseRange(%q) = %v, expected error", s, r)
+		}
+	}
+}
+
+func TestRangeCombos(t *testing.T) {
+	r, err := ParseRange("22+, A2+, K2+, Q2+, J2+, T2+, 92+, 82+, 72+, 62+, 52+, 42+, 32")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r) != 1326 {
+		t.Errorf("got %d combos in the full range, want 1326", len(r))
+	}
+	seen := map[[2]Card]bool{}
+	for _, c := range r {
+		if seen[c.Cards] {
+			t.Errorf("combo %v appears twice", c.Cards)
+		}
+		seen[c.Cards] = true
+		if c.Cards[0].RawRank() < c.Cards[1].RawRank() {
+			t.Errorf("combo %v has lowest rank first", c.Cards)
+		}
+	}
+}
+
+func mustParseRange(t testing.TB, s string) Range {
+	r, err := ParseRange(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
+
+func withBoards(eqs []Equity, boards int) []Equity {
+	for i := range eqs {
+		eqs[i].Boards = boards
+	}
+	return eqs
+}
+
+func TestRangeEquities(t *testing.T) {
+	board := parseHands(t, "C2 D7 H9 SJ")[0]
+	heq := func(hands ...string) []Equity {
+		eqs, err := HoldemEquities(holdemHands(parseHands(t, hands...)), board)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return eqs
+	}
+	aa, kk := heq("SA HA", "SQ HQ"), heq("SK HK", "SQ HQ")
+	tcs := []struct {
+		ranges []string
+		want   []Equity
+	}{
+		{
+			ranges: []string{"AsAh", "QsQh"},
+			want:   aa,
+		},
+		{
+			// Only AdAc doesn't share a card with the other hand.
+			ranges: []string{"AA", "AsAh"},
+			want:   heq("DA CA", "SA HA"),
+		},
+		{
+			// The board has the 2c, so only 2d2h, 2d2s and 2h2s are
+			// possible. They all have the same equity.
+			ranges: []string{"22", "AsAh"},
+			want:   withBoards(heq("D2 H2", "SA HA"), 3*44),
+		},
+		{
+			ranges: []string{"AsAh, KsKh:0.5", "QsQh"},
+			want: []Equity{
+				{
+					Equity: (aa[0].Equity + 0.5*kk[0].Equity) / 1.5,
+					Win:    (aa[0].Win + 0.5*kk[0].Win) / 1.5,
+					Tie:    (aa[0].Tie + 0.5*kk[0].Tie) / 1.5,
+					Boards: aa[0].Boards + kk[0].Boards,
+				},
+				{
+					Equity: (aa[1].Equity + 0.5*kk[1].Equity) / 1.5,
+					Win:    (aa[1].Win + 0.5*kk[1].Win) / 1.5,
+					Tie:    (aa[1].Tie + 0.5*kk[1].Tie) / 1.5,
+					Boards: aa[1].Boards + kk[1].Boards,
+				},
+			},
+		},
+	}
+	for _, tc := range tcs {
+		var ranges []Range
+		for _, r := range tc.ranges {
+			ranges = append(ranges, mustParseRange(t, r))
+		}
+		got, err := RangeEquities(ranges, board)
+		if err != nil {
+			t.Fatalf("%v: failed to compute equities: %v", tc.ranges, err)
+		}
+		for i := range got {
+			if got[i].Boards != tc.want[i].Boards || math.Abs(got[i].Equity-tc.want[i].Equity) > 1e-9 ||
+				math.Abs(got[i].Win-tc.want[i].Win) > 1e-9 || math.Abs(got[i].Tie-tc.want[i].Tie) > 1e-9 {
+				t.Errorf("%v: range %s got %+v, want %+v", tc.ranges, tc.ranges[i], got[i], tc.want[i])
+			}
+		}
+	}
+}
+
+func TestFullRange(t *testing.T) {
+	r := FullRange()
+	if len(r) != 1326 {
+		t.Errorf("full range has %d combos, want 1326", len(r))
+	}
+	want := "22+, A2+, K2+, Q2+, J2+, T2+, 92+, 82+, 72+, 62+, 52+, 42+, 32"
+	if got := r.String(); got != want {
+		t.Errorf("full range is %q, want %q", got, want)
+	}
+	h := holdemHands(parseHands(t, "H7 SA"))[0]
+	if got := HandRange(h).String(); got != "As7h" {
+		t.Errorf("HandRange(%v) = %q, want %q", h, got, "As7h")
+	}
+}
+
+func TestRangeEquitiesWithOptions(t *testing.T) {
+	// Without dead cards, heads-up preflop equities come from the
+	// precomputed table, and otherwise every runout is evaluated.
+	hand := holdemHands(parseHands(t, "SA HA"))[0]
+	ranges := []Range{HandRange(hand), mustParseRange(t, "KK, 76s")}
+	for _, dead := range []Hand{nil, parseHands(t, "CK D7")[0]} {
+		want := make([]Equity, 2)
+		ds, _ := dead.CardSet()
+		n := 0
+		for _, c := range ranges[1] {
+			if ds.Contains(c.Cards[0]) || ds.Contains(c.Cards[1]) {
+				continue
+			}
+			eqs, err := HoldemEquitiesWithOptions([][2]Card{hand, c.Cards}, nil, EquityOptions{Dead: dead})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i, e := range eqs {
+				want[i].Equity += e.Equity
+				want[i].Win += e.Win
+				want[i].Tie += e.Tie
+				want[i].Boards += e.Boards
+			}
+			n++
+		}
+		for i := range want {
+			want[i].Equity /= float64(n)
+			want[i].Win /= float64(n)
+			want[i].Tie /= float64(n)
+		}
+
+		calls := 0
+		got, err := RangeEquitiesWithOptions(ranges, nil, EquityOptions{
+			Dead: dead,
+			Progress: func(done, total int) {
+				calls++
+				if done != calls || total != n {
+					t.Errorf("dead %v: bad progress %d/%d after %d calls", dead, done, total, calls)
+				}
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := range got {
+			if got[i].Boards != want[i].Boards || math.Abs(got[i].Equity-want[i].Equity) > 1e-9 ||
+				math.Abs(got[i].Win-want[i].Win) > 1e-9 || math.Abs(got[i].Tie-want[i].Tie) > 1e-9 {
+				t.Errorf("dead %v: range %d got %+v, want %+v", dead, i, got[i], want[i])
+			}
+		}
+		if calls != n {
+			t.Errorf("dead %v: progress called %d times, want %d", dead, calls, n)
+		}
+	}
+}
+
+func TestRangeEquitiesSampled(t *testing.T) {
+	ranges := []Range{mustParseRange(t, "TT+, AK"), mustParseRange(t, "QQ, AQs, 76s")}
+	board := parseHands(t, "CA D7 H9 SJ")[0]
+	want, err := RangeEquities(ranges, board)
+	if err != nil {
+		t.Fatalf("failed to compute equities: %v", err)
+	}
+	got, err := RangeEquitiesSampled(ranges, board, SampleOptions{Samples: 20000, Rand: rand.New(rand.NewSource(42))})
+	if err != nil {
+		t.Fatalf("failed to estimate equities: %v", err)
+	}
+	for i := range got {
+		if d := math.Abs(got[i].Equity.Equity - want[i].Equity); d > 5*got[i].StdErr {
+			t.Errorf("range %d has estimated equity %f (stderr %f), want %f", i, got[i].Equity.Equity, got[i].StdErr, want[i].Equity)
+		}
+	}
+}
+
+func TestRangeEquitiesConflicts(t *testing.T) {
+	ranges := []Range{mustParseRange(t, "AsAh"), mustParseRange(t, "AsKd, AhKd")}
+	if eqs, err := RangeEquities(ranges, nil); err == nil {
+		t.Errorf("got %+v, expected error for ranges that always share cards", eqs)
+	}
+	if eqs, err := RangeEquitiesSampled(ranges, nil, SampleOptions{Samples: 100}); err == nil {
+		t.Errorf("got %+v, expected error for ranges that always share cards", eqs)
+	}
+	if eqs, err := RangeEquities([]Range{mustParseRange(t, "AA"), mustParseRange(t, "KK")}, parseHands(t, "SA HA DA")[0]); err == nil {
+		t.Errorf("got %+v, expected error for range that always shares cards with the board", eqs)
+	}
+
+	// Dead cards can't be in any hand.
+	ranges = []Range{mustParseRange(t, "AA"), mustParseRange(t, "KK")}
+	dead := parseHands(t, "SA HA DA")[0]
+	if eqs, err := RangeEquitiesSampled(ranges, nil, SampleOptions{Samples: 100, Dead: dead}); err == nil {
+		t.Errorf("got %+v, expected error for range that always shares cards with the dead cards", eqs)
+	}
+	if eqs, err := RangeEquitiesSampled(ranges, dead, SampleOptions{Samples: 100, Dead: dead}); err == nil {
+		t.Errorf("got %+v, expected error for dead cards on the board", eqs)
+	}
+
+	// With every other card dead, there are no cards left for the river.
+	hands := holdemHands(parseHands(t, "SA HA", "SK HK"))
+	board := parseHands(t, "D2 HQ S7 C3")[0]
+	deck, err := getRemainingDeck(hands, board)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ranges = []Range{HandRange(hands[0]), HandRange(hands[1])}
+	if eqs, err := RangeEquitiesSampled(ranges, board, SampleOptions{Samples: 100, Dead: deck}); err == nil {
+		t.Errorf("got %+v, expected error when every card that isn't in a hand is dead", eqs)
+	}
+}
@@ -0,0 +1,180 @@
+package poker
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// SampleOptions configures the estimation of equities by sampling
+// random runouts, rather than enumerating every runout.
+type SampleOptions struct {
+	// Samples is the maximum number of runouts to sample.
+	// If zero, there's no limit and TargetStdErr must be set.
+	Samples int
+
+	// TargetStdErr, if positive, causes sampling to stop once the
+	// standard error of every hand's equity is at most this value.
+	TargetStdErr float64
+
+	// Rand is the source of randomness used to pick runouts.
+	// If nil, a generator with a fixed seed is used, so that results
+	// are reproducible.
+	Rand *rand.Rand
+
+	// Dead is cards known to be out of play, for example folded or
+	// exposed cards, which can't appear in any runout or randomly
+	// dealt hand. They mustn't be in any of the hands or on the board.
+	Dead []Card
+
+	// Categories, if true, causes each hand's estimated equity from
+	// HoldemEquitiesSampled or RangeEquitiesSampled to include counts
+	// of the sampled runouts by the hand's final category.
+	Categories bool
+}
+
+// sampleCheckInterval is how many samples are taken between checks
+// of the standard error against the target.
+const sampleCheckInterval = 1000
+
+// EquityEstimate is an estimate of the equity of a hand, computed
+// by sampling runouts. Boards is the number of runouts sampled.
+type EquityEstimate struct {
+	Equity
+	StdErr float64 `json:"stderr"` // the standard error of the estimate of Equity
+}
+
+// Interval95 returns an approximate 95% confidence interval for
+// the hand's equity.
+func (e EquityEstimate) Interval95() (lo, hi float64) {
+	return e.Equity.Equity - 1.96*e.StdErr, e.Equity.Equity + 1.96*e.StdErr
+}
+
+// stdErrs returns the standard error of the equity of each hand,
+// treating the counts as T independent samples.
+func (ec *equityCounts) stdErrs(T int) []float64 {
+	errs := make([]float64, ec.H)
+	if T < 2 {
+		return errs
+	}
+	for i := range errs {
+		// The equity won on a single runout is 1/k if the pot
+		// was split k ways, and 0 otherwise.
+		var sum, sumSq float64
+		for k := 1; k <= ec.H; k++ {
+			n := float64(ec.shares[i*(ec.H+1)+k])
+			sum += n / float64(k)
+			sumSq += n / float64(k*k)
+		}
+		mean := sum / float64(T)
+		v := (sumSq/float64(T) - mean*mean) * float64(T) / float64(T-1)
+		if v < 0 {
+			v = 0
+		}
+		errs[i] = math.Sqrt(v / float64(T))
+	}
+	return errs
+}
+
+// estimates converts counts accumulated over T sampled runouts
+// into equity estimates.
+func (ec *equityCounts) estimates(T int) []EquityEstimate {
+	eqs := ec.equities(T)
+	errs := ec.stdErrs(T)
+	r := make([]EquityEstimate, len(eqs))
+	for i := range eqs {
+		r[i] = EquityEstimate{Equity: eqs[i], StdErr: errs[i]}
+	}
+	return r
+}
+
+// done reports whether sampling can stop after T samples.
+func (opts *SampleOptions) done(ec *equityCounts, T int) bool {
+	if opts.Samples > 0 && T >= opts.Samples {
+		return true
+	}
+	if opts.TargetStdErr <= 0 || T%sampleCheckInterval != 0 {
+		return false
+	}
+	for _, e := range ec.stdErrs(T) {
+		if e > opts.TargetStdErr {
+			return false
+		}
+	}
+	return true
+}
+
+func (opts *SampleOptions) check() error {
+	if opts.Samples < 0 {
+		return fmt.Errorf("negative number of samples %d", opts.Samples)
+	}
+	if opts.TargetStdErr < 0 {
+		return fmt.Errorf("negative target standard error %f", opts.TargetStdErr)
+	}
+	if opts.Samples == 0 && opts.TargetStdErr == 0 {
+		return fmt.Errorf("one of Samples and TargetStdErr must be set")
+	}
+	return nil
+}
+
+func (opts *SampleOptions) rand() *rand.Rand {
+	if opts.Rand == nil {
+		return rand.New(rand.NewSource(1))
+	}
+	return opts.Rand
+}
+
+// HoldemEquitiesSampled estimates the river equities for the given
+// holdem hands given a board of up to 5 cards, by sampling random
+// runouts rather than enumerating all of them.
+// The hands and board must be distinct, and the board can't have more
+// than 5 cards in it.
+func HoldemEquitiesSampled(hands [][2]Card, board []Card, opts SampleOptions) ([]EquityEstimate, error) {
+	if err := opts.check(); err != nil {
+		return nil, err
+	}
+	deck, err := getRemainingDeck(hands, board)
+	if err != nil {
+		return nil, err
+	}
+	if deck, err = removeDeadCards(deck, opts.Dead, 5-len(board)); err != nil {
+		return nil, err
+	}
+	rnd := opts.rand()
+
+	// As in HoldemEquities, the fixed cards are stored at the
+	// end of each hand.
+	hbs := make([][7]Card, len(hands))
+	for i, h := range hands {
+		hbs[i][7-len(board)-2+0] = h[0]
+		hbs[i][7-len(board)-2+1] = h[1]
+		for j, b := range board {
+			hbs[i][7-len(board)-2+2+j] = b
+		}
+	}
+
+	ec := newEquityCounts(len(hands)).trackCategories(opts.Categories)
+	evs := make([]int16, len(hands))
+	k := 5 - len(board)
+	if k == 0 {
+		holdemRiverEquities(hbs, evs, ec)
+		return ec.estimates(1), nil
+	}
+	T := 0
+	for {
+		// Pick the runout by partially shuffling the deck.
+		for j := 0; j < k; j++ {
+			r := j + rnd.Intn(len(deck)-j)
+			deck[j], deck[r] = deck[r], deck[j]
+			for i := range hbs {
+				hbs[i][j] = deck[j]
+			}
+		}
+		holdemRiverEquities(hbs, evs, ec)
+		T++
+		if opts.done(ec, T) {
+			break
+		}
+	}
+	return ec.estimates(T), nil
+}
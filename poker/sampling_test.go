@@ -0,0 +1,86 @@
+package poker
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestHoldemEquitiesSampled(t *testing.T) {
+	tcs := []struct {
+		hands []string
+		board string
+	}{
+		{hands: []string{"CA HK", "DK HT"}},
+		{hands: []string{"CA HK", "DK HT", "H9 D9"}, board: "D2 H2 S2"},
+		{hands: []string{"CA HK", "DK HT", "H9 D9", "S3 C4"}, board: "D2 H5"},
+	}
+	for _, tc := range tcs {
+		hands := holdemHands(parseHands(t, tc.hands...))
+		var board []Card
+		if tc.board != "" {
+			board = parseHands(t, tc.board)[0]
+		}
+		want, err := HoldemEquities(hands, board)
+		if err != nil {
+			t.Fatalf("failed to compute equities: %v", err)
+		}
+		opts := SampleOptions{Samples: 20000, Rand: rand.New(rand.NewSource(42))}
+		got, err := HoldemEquitiesSampled(hands, board, opts)
+		if err != nil {
+			t.Fatalf("failed to estimate equities: %v", err)
+		}
+		for i := range got {
+			if got[i].Boards != opts.Samples {
+				t.Errorf("%v on %q: got %d boards, want %d", tc.hands, tc.board, got[i].Boards, opts.Samples)
+			}
+			// The estimate should very rarely be more than 5 standard
+			// errors from the true equity, and the seed is fixed.
+			if d := math.Abs(got[i].Equity.Equity - want[i].Equity); d > 5*got[i].StdErr {
+				t.Errorf("%v on %q: hand %s has estimated equity %f (stderr %f), want %f", tc.hands, tc.board, tc.hands[i], got[i].Equity.Equity, got[i].StdErr, want[i].Equity)
+			}
+			if lo, hi := got[i].Interval95(); lo > got[i].Equity.Equity || hi < got[i].Equity.Equity {
+				t.Errorf("%v on %q: interval [%f, %f] doesn't contain estimate %f", tc.hands, tc.board, lo, hi, got[i].Equity.Equity)
+			}
+		}
+	}
+}
+
+func TestHoldemEquitiesSampledSeed(t *testing.T) {
+	hands := holdemHands(parseHands(t, "CA HK", "DK HT", "H9 D9"))
+	est := func(seed int64) []EquityEstimate {
+		eqs, err := HoldemEquitiesSampled(hands, nil, SampleOptions{Samples: 1000, Rand: rand.New(rand.NewSource(seed))})
+		if err != nil {
+			t.Fatalf("failed to estimate equities: %v", err)
+		}
+		return eqs
+	}
+	if a, b := est(1), est(1); !reflect.DeepEqual(a, b) {
+		t.Errorf("same seed gave different estimates: %+v and %+v", a, b)
+	}
+	if a, b := est(1), est(2); reflect.DeepEqual(a, b) {
+		t.Errorf("different seeds gave identical estimates: %+v", a)
+	}
+}
+
+func TestHoldemEquitiesSampledTarget(t *testing.T) {
+	hands := holdemHands(parseHands(t, "CA HK", "DK HT"))
+	const target = 0.005
+	eqs, err := HoldemEquitiesSampled(hands, nil, SampleOptions{TargetStdErr: target})
+	if err != nil {
+		t.Fatalf("failed to estimate equities: %v", err)
+	}
+	for _, eq := range eqs {
+		if eq.StdErr > target {
+			t.Errorf("got stderr %f, want at most %f", eq.StdErr, target)
+		}
+		if eq.Boards%sampleCheckInterval != 0 {
+			t.Errorf("sampled %d boards, expected a multiple of %d", eq.Boards, sampleCheckInterval)
+		}
+	}
+
+	if _, err := HoldemEquitiesSampled(hands, nil, SampleOptions{}); err == nil {
+		t.Errorf("expected error with no samples or target")
+	}
+}
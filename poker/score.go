@@ -0,0 +1,116 @@
+package poker
+
+import "fmt"
+
+// A HandCategory is the type of a poker hand, for example a flush.
+// Categories are ordered from the weakest to the strongest.
+type HandCategory int
+
+// Hand categories.
+const (
+	HighCard HandCategory = iota
+	OnePair
+	TwoPair
+	ThreeOfAKind
+	Straight
+	Flush
+	FullHouse
+	FourOfAKind
+	StraightFlush
+	FiveOfAKind
+)
+
+var handCategories = []string{
+	HighCard:      "high card",
+	OnePair:       "one pair",
+	TwoPair:       "two pair",
+	ThreeOfAKind:  "three of a kind",
+	Straight:      "straight",
+	Flush:         "flush",
+	FullHouse:     "full house",
+	FourOfAKind:   "four of a kind",
+	StraightFlush: "straight flush",
+	FiveOfAKind:   "five of a kind",
+}
+
+// String returns the name of the category, for example "full house".
+func (hc HandCategory) String() string {
+	if hc < 0 || int(hc) >= len(handCategories) {
+		return "?"
+	}
+	return handCategories[hc]
+}
+
+// primaryRanks is how many of the ranks in a hand of each category
+// make up the category itself, rather than being kickers.
+var primaryRanks = []int{
+	HighCard:      1,
+	OnePair:       1,
+	TwoPair:       2,
+	ThreeOfAKind:  1,
+	Straight:      1,
+	Flush:         5,
+	FullHouse:     2,
+	FourOfAKind:   1,
+	StraightFlush: 1,
+	FiveOfAKind:   1,
+}
+
+// ScoreCategory returns the category of a hand with the given score,
+// as returned by Eval3, Eval5 or Eval7. It returns an error if the
+// score isn't between 0 and ScoreMax (inclusive).
+func ScoreCategory(e int16) (HandCategory, error) {
+	if e < 0 || e > ScoreMax {
+		return 0, fmt.Errorf("invalid score %d", e)
+	}
+	return scoreCategory(e), nil
+}
+
+// scoreCategory returns the category of a hand with the given score,
+// which must be valid.
+func scoreCategory(e int16) HandCategory {
+	return HandCategory(evalInfo.packedToSlowRank[e] >> 20)
+}
+
+// ScoreRanks returns the ranks that determine the strength of a hand
+// with the given score, as returned by Eval3, Eval5 or Eval7.
+// The primary ranks are those that make up the hand's category: the
+// rank of a pair or trips, the ranks of two pair or a full house (the
+// trips first), the top card of a straight, or all the cards of a
+// flush. For a high card hand, it's the highest card. The kickers are
+// the other ranks that matter, in decreasing order.
+// It returns an error if the score isn't between 0 and ScoreMax
+// (inclusive).
+func ScoreRanks(e int16) (primary, kickers []Rank, err error) {
+	if e < 0 || e > ScoreMax {
+		return nil, nil, fmt.Errorf("invalid score %d", e)
+	}
+	r := evalInfo.packedToSlowRank[e]
+	np := primaryRanks[r>>20]
+	for i := 4; i >= 0; i-- {
+		// Ranks are stored in nibbles from 2 to 14 (ace).
+		n := (r >> (4 * uint(i))) & 15
+		if n == 0 {
+			continue
+		}
+		if len(primary) < np {
+			primary = append(primary, Rank((n-1)%13+1))
+		} else {
+			kickers = append(kickers, Rank((n-1)%13+1))
+		}
+	}
+	return primary, kickers, nil
+}
+
+// DescribeScore describes a hand with the given score, as returned by
+// Eval3, Eval5 or Eval7. The description is the same as Describe
+// gives for a hand with that score.
+func DescribeScore(e int16) (string, error) {
+	if e < 0 || e > ScoreMax {
+		return "", fmt.Errorf("invalid score %d", e)
+	}
+	if h := evalInfo.rankTo5[e]; len(h) != 0 {
+		return Describe(h)
+	}
+	return Describe(evalInfo.rankTo3[e])
+}
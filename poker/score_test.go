@@ -0,0 +1,78 @@
+package poker
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestScoreRanks(t *testing.T) {
+	tcs := []struct {
+		hand         string
+		wantCategory HandCategory
+		wantPrimary  []Rank
+		wantKickers  []Rank
+	}{
+		{"SA H9 D7 C4 S2", HighCard, []Rank{1}, []Rank{9, 7, 4, 2}},
+		{"SA HA D7 C4 S2", OnePair, []Rank{1}, []Rank{7, 4, 2}},
+		{"SA HA D7 C7 S2", TwoPair, []Rank{1, 7}, []Rank{2}},
+		{"S3 H3 D3 CK S2", ThreeOfAKind, []Rank{3}, []Rank{13, 2}},
+		{"S5 H4 D3 C2 SA", Straight, []Rank{5}, nil},
+		{"HK HJ H8 H6 H2", Flush, []Rank{13, 11, 8, 6, 2}, nil},
+		{"S3 H3 D3 CK SK", FullHouse, []Rank{3, 13}, nil},
+		{"S3 H3 D3 C3 SK", FourOfAKind, []Rank{3}, []Rank{13}},
+		{"HA HK HQ HJ HT", StraightFlush, []Rank{1}, nil},
+		{"SQ HQ D2", OnePair, []Rank{12}, []Rank{2}},
+		{"SQ HQ DQ", ThreeOfAKind, []Rank{12}, nil},
+	}
+	for _, tc := range tcs {
+		h := parseHands(t, tc.hand)[0]
+		e := EvalSlow(h)
+		if got, err := ScoreCategory(e); err != nil || got != tc.wantCategory {
+			t.Errorf("ScoreCategory(%s) = %s, %v, want %s", tc.hand, got, err, tc.wantCategory)
+		}
+		p, k, err := ScoreRanks(e)
+		if err != nil {
+			t.Errorf("ScoreRanks(%s) failed: %v", tc.hand, err)
+		} else if !reflect.DeepEqual(p, tc.wantPrimary) || !reflect.DeepEqual(k, tc.wantKickers) {
+			t.Errorf("ScoreRanks(%s) = %v, %v, want %v, %v", tc.hand, p, k, tc.wantPrimary, tc.wantKickers)
+		}
+	}
+}
+
+func TestDescribeScore(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	for i := 0; i < 10000; i++ {
+		var h7 [7]Card
+		for j, p := range rnd.Perm(52)[:7] {
+			h7[j] = Cards[p]
+		}
+		want, err := Describe(h7[:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := DescribeScore(Eval7(&h7))
+		if err != nil || got != want {
+			t.Errorf("DescribeScore of %v = %q (err %v), want %q", Hand(h7[:]), got, err, want)
+		}
+	}
+	for e := int16(0); e <= ScoreMax; e++ {
+		if _, err := DescribeScore(e); err != nil {
+			t.Errorf("DescribeScore(%d) failed: %v", e, err)
+		}
+		if c, err := ScoreCategory(e); err != nil || c.String() == "?" {
+			t.Errorf("ScoreCategory(%d) = %d, %v", e, c, err)
+		}
+	}
+	for _, e := range []int16{-1, ScoreMax + 1} {
+		if _, err := DescribeScore(e); err == nil {
+			t.Errorf("expected error from DescribeScore(%d)", e)
+		}
+		if _, err := ScoreCategory(e); err == nil {
+			t.Errorf("expected error from ScoreCategory(%d)", e)
+		}
+		if _, _, err := ScoreRanks(e); err == nil {
+			t.Errorf("expected error from ScoreRanks(%d)", e)
+		}
+	}
+}
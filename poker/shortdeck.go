@@ -0,0 +1,312 @@
+package poker
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+)
+
+// ShortDeckScoreMax is the largest possible rank of hand returned by
+// the short-deck Eval functions.
+const ShortDeckScoreMax = 1403
+
+// In short-deck (6+) hold'em, the deuces through fives are removed
+// from the deck, leaving 36 cards. A flush beats a full house,
+// and A-6-7-8-9 is the lowest straight. Otherwise hands rank as
+// in regular poker.
+//
+// The short-deck evaluators use state machines generated in the same
+// way as the ones used by Eval5 and Eval7, but with only transitions
+// for short-deck cards. The tables are generated the first time
+// they're needed, which takes a little time.
+
+// ShortDeckCards is a full short deck of all cards from 6 to ace.
+// Sorted by suit and then rank.
+var ShortDeckCards = makeShortDeckCards()
+
+func makeShortDeckCards() []Card {
+	var cards []Card
+	for _, c := range Cards {
+		if inShortDeck(c) {
+			cards = append(cards, c)
+		}
+	}
+	return cards
+}
+
+// inShortDeck reports whether the card is a valid short-deck card.
+func inShortDeck(c Card) bool {
+	r := c.Rank()
+	return c.Valid() && (r == 1 || r >= 6)
+}
+
+// shortDeckSlowRank ranks a 5-card short-deck hand. Like the rank
+// in the result of evalSlow, the result can be used to compare hands,
+// but isn't packed.
+func shortDeckSlowRank(c []Card) (int, error) {
+	ev, err := evalSlow(c, true, false)
+	if err != nil {
+		return 0, err
+	}
+	var ranks uint16
+	for _, ci := range c {
+		ranks |= 1 << ci.Rank()
+	}
+	if ranks == 1<<1|1<<6|1<<7|1<<8|1<<9 {
+		if isFlush(c) {
+			return evalScore5(8, 9, 0, 0, 0, 0).rank, nil
+		}
+		return evalScore5(4, 9, 0, 0, 0, 0).rank, nil
+	}
+	// The hand type is stored above 5 nibbles. Swap flushes (5)
+	// and full houses (6).
+	switch ev.rank >> 20 {
+	case 5:
+		ev.rank += 1 << 20
+	case 6:
+		ev.rank -= 1 << 20
+	}
+	return ev.rank, nil
+}
+
+type shortDeckTables struct {
+	slowRankToPacked map[int]int16
+	// tbl5 and tbl7 are flattened state machines in the same
+	// format as rootNode5table and rootNode7table.
+	tbl5, tbl7 []uint32
+}
+
+var (
+	shortDeckInfo     *shortDeckTables
+	shortDeckInfoInit sync.Once
+)
+
+// shortDeckTbls returns the short-deck tables, building them on first use.
+func shortDeckTbls() *shortDeckTables {
+	shortDeckInfoInit.Do(func() {
+		shortDeckInfo = makeShortDeckTables()
+	})
+	return shortDeckInfo
+}
+
+// flattenTree returns the state machine rooted at node in the
+// format generated by genTables.
+func flattenTree(ncards int, node *tblNode) []uint32 {
+	n := indexNodes(node)
+	tbl := make([]uint32, n*52)
+	genTables(ncards, tbl, node, make([]bool, n))
+	return tbl
+}
+
+func makeShortDeckTables() *shortDeckTables {
+	ranks := []Rank{1, 6, 7, 8, 9, 10, 11, 12, 13}
+	var scores []int
+	seen := map[int]bool{}
+	add := func(h []Card) {
+		r, err := shortDeckSlowRank(h)
+		if err != nil {
+			log.Fatalf("failed to rank %v: %v", Hand(h), err)
+		}
+		// Five of a kind is impossible with a single deck.
+		if r>>20 == 9 || seen[r] {
+			return
+		}
+		seen[r] = true
+		scores = append(scores, r)
+	}
+	// Enumerate all flushes, and then all non-flush hands.
+	for a := 0; a < 9; a++ {
+		for b := a + 1; b < 9; b++ {
+			for c := b + 1; c < 9; c++ {
+				for d := c + 1; d < 9; d++ {
+					for e := d + 1; e < 9; e++ {
+						add([]Card{
+							mustMakeCard(Club, ranks[a]),
+							mustMakeCard(Club, ranks[b]),
+							mustMakeCard(Club, ranks[c]),
+							mustMakeCard(Club, ranks[d]),
+							mustMakeCard(Club, ranks[e]),
+						})
+					}
+				}
+			}
+		}
+	}
+	for a := 0; a < 9; a++ {
+		for b := a; b < 9; b++ {
+			for c := b; c < 9; c++ {
+				for d := c; d < 9; d++ {
+					for e := d; e < 9; e++ {
+						add([]Card{
+							mustMakeCard(Club, ranks[a]),
+							mustMakeCard(Diamond, ranks[b]),
+							mustMakeCard(Heart, ranks[c]),
+							mustMakeCard(Spade, ranks[d]),
+							mustMakeCard(Club, ranks[e]),
+						})
+					}
+				}
+			}
+		}
+	}
+	sort.Ints(scores)
+	if ShortDeckScoreMax != len(scores)-1 {
+		log.Fatalf("Expected max short-deck score of %d, but found %d", ShortDeckScoreMax, len(scores)-1)
+	}
+	st := &shortDeckTables{slowRankToPacked: map[int]int16{}}
+	for i, r := range scores {
+		st.slowRankToPacked[r] = int16(i)
+	}
+
+	st.tbl5 = flattenTree(5, gentreeDeck(5, ShortDeckCards, func(c []Card) int16 {
+		r, err := shortDeckSlowRank(c)
+		if err != nil {
+			log.Fatalf("failed to rank %v: %v", Hand(c), err)
+		}
+		return st.slowRankToPacked[r]
+	}))
+	st.tbl7 = flattenTree(7, gentreeDeck(7, ShortDeckCards, func(c []Card) int16 {
+		best := int16(-1)
+		for a := 0; a < 7; a++ {
+			for b := a + 1; b < 7; b++ {
+				var h [5]Card
+				k := 0
+				for i := 0; i < 7; i++ {
+					if i != a && i != b {
+						h[k] = c[i]
+						k++
+					}
+				}
+				if ev := evalTable5(st.tbl5, &h); ev > best {
+					best = ev
+				}
+			}
+		}
+		return best
+	}))
+	return st
+}
+
+// evalTable5 evaluates a 5-card hand using a table in the same
+// format as rootNode5table.
+func evalTable5(tbl []uint32, hand *[5]Card) int16 {
+	v := tbl[hand[0]]
+	tx := suitTransformByte(v)
+	idx := int(v >> 8)
+
+	v = tbl[idx+int(tx.Apply(hand[1]))]
+	tx = tx.Compose(suitTransformByte(v))
+	idx = int(v >> 8)
+
+	v = tbl[idx+int(tx.Apply(hand[2]))]
+	tx = tx.Compose(suitTransformByte(v))
+	idx = int(v >> 8)
+
+	v = tbl[idx+int(tx.Apply(hand[3]))]
+	tx = tx.Compose(suitTransformByte(v))
+	idx = int(v >> 8)
+
+	return int16(tbl[idx+int(tx.Apply(hand[4]))])
+}
+
+// EvalShortDeckSlow takes a 5-card short-deck hand and returns a
+// number which can be used to rank it against other short-deck hands.
+// The returned value is in the range 0 to ShortDeckScoreMax.
+// This function should not generally be used, and EvalShortDeck5
+// or EvalShortDeck7 used instead.
+func EvalShortDeckSlow(c []Card) (int16, error) {
+	if len(c) != 5 {
+		return 0, fmt.Errorf("can't evaluate a %d card short-deck hand", len(c))
+	}
+	for _, ci := range c {
+		if !inShortDeck(ci) {
+			return 0, fmt.Errorf("card %s is not in the short deck", ci)
+		}
+	}
+	r, err := shortDeckSlowRank(c)
+	if err != nil {
+		return 0, err
+	}
+	return shortDeckTbls().slowRankToPacked[r], nil
+}
+
+// EvalShortDeck5 evaluates a 5-card short-deck poker hand, returning a
+// rank for the hand from 0 to ShortDeckScoreMax (inclusive).
+// The cards must all be in the short deck.
+func EvalShortDeck5(hand *[5]Card) int16 {
+	return evalTable5(shortDeckTbls().tbl5, hand)
+}
+
+// EvalShortDeck7 evaluates a 7-card short-deck poker hand, returning a
+// rank for the hand from 0 to ShortDeckScoreMax (inclusive).
+// The cards must all be in the short deck.
+func EvalShortDeck7(hand *[7]Card) int16 {
+	tbl := shortDeckTbls().tbl7
+	v := tbl[hand[0]]
+	tx := suitTransformByte(v)
+	idx := int(v >> 8)
+
+	v = tbl[idx+int(tx.Apply(hand[1]))]
+	tx = tx.Compose(suitTransformByte(v))
+	idx = int(v >> 8)
+
+	v = tbl[idx+int(tx.Apply(hand[2]))]
+	tx = tx.Compose(suitTransformByte(v))
+	idx = int(v >> 8)
+
+	v = tbl[idx+int(tx.Apply(hand[3]))]
+	tx = tx.Compose(suitTransformByte(v))
+	idx = int(v >> 8)
+
+	v = tbl[idx+int(tx.Apply(hand[4]))]
+	tx = tx.Compose(suitTransformByte(v))
+	idx = int(v >> 8)
+
+	v = tbl[idx+int(tx.Apply(hand[5]))]
+	tx = tx.Compose(suitTransformByte(v))
+	idx = int(v >> 8)
+
+	return int16(tbl[idx+int(tx.Apply(hand[6]))])
+}
+
+// ShortDeckEquities returns the river equities for the given short-deck
+// hold'em hands given a board of up to 5 cards. The runouts
+// are drawn from the 36-card short deck.
+// The hands and board must be distinct short-deck cards, and the board
+// can't have more than 5 cards in it.
+func ShortDeckEquities(hands [][2]Card, board []Card) ([]Equity, error) {
+	for i, h := range hands {
+		for _, c := range h {
+			if c.Valid() && !inShortDeck(c) {
+				return nil, fmt.Errorf("hand %d contains card %s which is not in the short deck", i, c)
+			}
+		}
+	}
+	for i, c := range board {
+		if c.Valid() && !inShortDeck(c) {
+			return nil, fmt.Errorf("board[%d] card %s is not in the short deck", i, c)
+		}
+	}
+	fullDeck, err := getRemainingDeck(hands, board)
+	if err != nil {
+		return nil, err
+	}
+	var deck []Card
+	for _, c := range fullDeck {
+		if inShortDeck(c) {
+			deck = append(deck, c)
+		}
+	}
+
+	ec := newEquityCounts(len(hands))
+	evs := make([]int16, len(hands))
+	T := forEachRunout(deck, board, func(brd *[5]Card) {
+		for i, h := range hands {
+			h7 := [7]Card{h[0], h[1], brd[0], brd[1], brd[2], brd[3], brd[4]}
+			evs[i] = EvalShortDeck7(&h7)
+		}
+		ec.add(evs)
+	})
+	return ec.equities(T), nil
+}
@@ -0,0 +1,125 @@
+package poker
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestShortDeckRankings(t *testing.T) {
+	// These hands are in descending order of strength.
+	hands := []string{
+		"HA HK HQ HJ HT",
+		"H9 H8 H7 H6 HA",
+		"HA SA DA CA C6",
+		"HA HK HQ H7 H6",
+		"SK HK DK C6 H6",
+		"CA SK SQ SJ ST",
+		"CT S9 S8 S7 S6",
+		"H9 D8 C7 D6 HA",
+		"HA DA CA C7 D6",
+		"HA DA CK DK H6",
+		"HA DA CK DQ D6",
+		"SA HQ H9 H7 H6",
+		"SK HQ HJ H8 H7",
+	}
+	prev := int16(ShortDeckScoreMax + 1)
+	prevHand := ""
+	for _, h := range hands {
+		c, err := parseHand(h)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ev, err := EvalShortDeckSlow(c)
+		if err != nil {
+			t.Fatalf("EvalShortDeckSlow(%s) failed: %v", h, err)
+		}
+		var h5 [5]Card
+		copy(h5[:], c)
+		if got := EvalShortDeck5(&h5); got != ev {
+			t.Errorf("EvalShortDeck5(%s) = %d, want %d", h, got, ev)
+		}
+		if ev >= prev {
+			t.Errorf("Expected %s to beat %s, but got scores %d and %d", prevHand, h, prev, ev)
+		}
+		prev, prevHand = ev, h
+	}
+	if _, err := EvalShortDeckSlow(parseHands(t, "SA S2 S3 S4 S5")[0]); err == nil {
+		t.Errorf("expected error evaluating non-short-deck cards")
+	}
+}
+
+func TestEvalShortDeck7(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	for i := 0; i < 20000; i++ {
+		perm := rnd.Perm(len(ShortDeckCards))
+		var h7 [7]Card
+		for j := range h7 {
+			h7[j] = ShortDeckCards[perm[j]]
+		}
+		want := int16(-1)
+		for a := 0; a < 7; a++ {
+			for b := a + 1; b < 7; b++ {
+				var h5 []Card
+				for j := 0; j < 7; j++ {
+					if j != a && j != b {
+						h5 = append(h5, h7[j])
+					}
+				}
+				ev, err := EvalShortDeckSlow(h5)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if ev > want {
+					want = ev
+				}
+			}
+		}
+		if got := EvalShortDeck7(&h7); got != want {
+			t.Errorf("EvalShortDeck7(%v) = %d, want %d", Hand(h7[:]), got, want)
+		}
+	}
+}
+
+func TestShortDeckEquities(t *testing.T) {
+	hands := [][2]Card{}
+	for _, h := range parseHands(t, "CA HK", "DK HT") {
+		hands = append(hands, [2]Card{h[0], h[1]})
+	}
+	eqs, err := ShortDeckEquities(hands, nil)
+	if err != nil {
+		t.Fatalf("failed to compute equities: %v", err)
+	}
+	wantBoards := 32 * 31 * 30 * 29 * 28 / (5 * 4 * 3 * 2)
+	total := 0.0
+	for _, eq := range eqs {
+		if eq.Boards != wantBoards {
+			t.Errorf("got %d boards, want %d", eq.Boards, wantBoards)
+		}
+		total += eq.Equity
+	}
+	if math.Abs(total-1) > 1e-9 {
+		t.Errorf("total equity is %f, want 1", total)
+	}
+	if eqs[0].Equity < eqs[1].Equity {
+		t.Errorf("expected AK to beat KT, got equities %f and %f", eqs[0].Equity, eqs[1].Equity)
+	}
+
+	// On this board, the flush beats the full house.
+	hands = [][2]Card{}
+	for _, h := range parseHands(t, "HA HK", "S8 C7") {
+		hands = append(hands, [2]Card{h[0], h[1]})
+	}
+	board := parseHands(t, "H6 H7 D8 HQ C8")[0]
+	eqs, err = ShortDeckEquities(hands, board)
+	if err != nil {
+		t.Fatalf("failed to compute equities: %v", err)
+	}
+	if eqs[0].Win != 1 {
+		t.Errorf("expected flush to beat full house, got equities %+v", eqs)
+	}
+
+	if _, err := ShortDeckEquities([][2]Card{{hands[0][0], mustMakeCard(Club, 2)}}, nil); err == nil {
+		t.Errorf("expected error for non-short-deck card")
+	}
+}
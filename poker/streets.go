@@ -0,0 +1,294 @@
+package poker
+
+import (
+	"fmt"
+	"math"
+)
+
+// A Street is a stage of a holdem hand, named by the board cards that
+// have been dealt.
+type Street int
+
+// The streets of a holdem hand.
+const (
+	Preflop Street = iota
+	Flop
+	Turn
+	River
+)
+
+var streetNames = [...]string{"preflop", "flop", "turn", "river"}
+
+func (s Street) String() string {
+	if s < Preflop || s > River {
+		return fmt.Sprintf("Street(%d)", int(s))
+	}
+	return streetNames[s]
+}
+
+// boardSize returns the number of board cards on the street.
+func (s Street) boardSize() int {
+	return [...]int{0, 3, 4, 5}[s]
+}
+
+// SwingBuckets is the number of buckets in the histograms of equity
+// swings in StreetEquities. The swings range from -1 to 1, so each
+// bucket is 0.1 wide.
+const SwingBuckets = 20
+
+// swingBucket returns the bucket of the histogram of swings that the
+// swing belongs in.
+func swingBucket(swing float64) int {
+	b := int(math.Floor((swing + 1) / 2 * SwingBuckets))
+	if b < 0 {
+		return 0
+	}
+	if b >= SwingBuckets {
+		return SwingBuckets - 1
+	}
+	return b
+}
+
+// StreetEquities summarizes the players' equities over every board on
+// one street. Boards that are dealt in a different order are counted
+// separately, so for example every river board is counted once for
+// each possible turn card, and every board on a street is equally
+// likely.
+type StreetEquities struct {
+	Street Street
+	Boards int // the number of boards on the street
+
+	// Mean is the mean equity of each player over the boards. It's
+	// the same as the player's equity before the street, and their
+	// final equity.
+	Mean []float64
+	// StdDev is the standard deviation of each player's equity over
+	// the boards.
+	StdDev []float64
+	// MeanAbsSwing is the mean absolute change in each player's
+	// equity from the previous street.
+	MeanAbsSwing []float64
+	// Swings is a histogram of the changes in each player's equity
+	// from the previous street. Swings[i][b] is the number of boards
+	// where player i's equity changed by between -1+0.1*b and
+	// -1+0.1*(b+1), with a change of exactly 1 in the last bucket.
+	Swings [][SwingBuckets]int
+}
+
+// StreetOptions holds optional settings for computing equities street
+// by street.
+type StreetOptions struct {
+	// Dead is cards known to be out of play, which can't appear on
+	// any board, as in EquityOptions.
+	Dead []Card
+
+	// Visit, if not nil, is called with every board on every street
+	// and the players' equities on that board, starting from the
+	// given board. A board is visited after all the boards that
+	// follow it. The board and equities mustn't be retained after
+	// Visit returns.
+	Visit func(board []Card, eqs []Equity)
+}
+
+// A StreetBreakdown is the result of HoldemStreetEquities.
+type StreetBreakdown struct {
+	// Equities is each player's equity on the given board, the same
+	// as from HoldemEquities.
+	Equities []Equity
+	// Streets summarizes the equities on each of the streets after
+	// the given board, in order.
+	Streets []StreetEquities
+}
+
+// streetStats accumulates the equities of the boards on a street.
+type streetStats struct {
+	boards    int
+	sum       []float64
+	sumSq     []float64
+	sumAbsSwg []float64
+	swings    [][SwingBuckets]int
+}
+
+func newStreetStats(H int) *streetStats {
+	return &streetStats{
+		sum:       make([]float64, H),
+		sumSq:     make([]float64, H),
+		sumAbsSwg: make([]float64, H),
+		swings:    make([][SwingBuckets]int, H),
+	}
+}
+
+// add records the equities eqs of a board whose previous street's
+// board had equities prev.
+func (ss *streetStats) add(eqs, prev []float64) {
+	ss.boards++
+	for i, e := range eqs {
+		ss.sum[i] += e
+		ss.sumSq[i] += e * e
+		swing := e - prev[i]
+		ss.sumAbsSwg[i] += math.Abs(swing)
+		ss.swings[i][swingBucket(swing)]++
+	}
+}
+
+func (ss *streetStats) equities(s Street) StreetEquities {
+	H := len(ss.sum)
+	se := StreetEquities{
+		Street:       s,
+		Boards:       ss.boards,
+		Mean:         make([]float64, H),
+		StdDev:       make([]float64, H),
+		MeanAbsSwing: make([]float64, H),
+		Swings:       ss.swings,
+	}
+	n := float64(ss.boards)
+	for i := 0; i < H; i++ {
+		se.Mean[i] = ss.sum[i] / n
+		v := ss.sumSq[i]/n - se.Mean[i]*se.Mean[i]
+		if v < 0 {
+			v = 0
+		}
+		se.StdDev[i] = math.Sqrt(v)
+		se.MeanAbsSwing[i] = ss.sumAbsSwg[i] / n
+	}
+	return se
+}
+
+// equityOf returns the equity of hand i from the counts accumulated
+// over T runouts.
+func (ec *equityCounts) equityOf(i, T int) float64 {
+	var eq float64
+	for k := 1; k <= ec.H; k++ {
+		eq += float64(ec.shares[i*(ec.H+1)+k]) / float64(k)
+	}
+	return eq / float64(T)
+}
+
+// HoldemStreetEquities returns the river equities for the given holdem
+// hands given a preflop, flop or turn board of 0, 3 or 4 cards, along
+// with the equities on every board on each later street, summarized
+// by street. Every board is evaluated, so computing preflop equities
+// this way is much slower than with HoldemEquities.
+// The hands and board must be distinct.
+func HoldemStreetEquities(hands [][2]Card, board []Card, opts StreetOptions) (*StreetBreakdown, error) {
+	var start Street
+	switch len(board) {
+	case 0:
+		start = Preflop
+	case 3:
+		start = Flop
+	case 4:
+		start = Turn
+	default:
+		return nil, fmt.Errorf("board %s must have 0, 3 or 4 cards", boardString(board))
+	}
+	deck, err := getRemainingDeck(hands, board)
+	if err != nil {
+		return nil, err
+	}
+	if deck, err = removeDeadCards(deck, opts.Dead, 5-len(board)); err != nil {
+		return nil, err
+	}
+
+	H := len(hands)
+	hbs := make([][7]Card, H)
+	for i, h := range hands {
+		hbs[i][0], hbs[i][1] = h[0], h[1]
+	}
+	evs := make([]int16, H)
+	var brd [5]Card
+	copy(brd[:], board)
+	stats := make([]*streetStats, River+1)
+	for s := start + 1; s <= River; s++ {
+		stats[s] = newStreetStats(H)
+	}
+	// The counts for a river board are reused, because there are
+	// so many of them.
+	riverCounts := newEquityCounts(H)
+	// equities converts the counts of the runouts from a board of n
+	// cards into equities. The runouts are counted in the order the
+	// cards are dealt, but Boards is the number of distinct runouts.
+	equities := func(ec *equityCounts, T, n int) []Equity {
+		eqs := ec.equities(T)
+		boards := binomial(len(deck)-(n-len(board)), 5-n)
+		for i := range eqs {
+			eqs[i].Boards = boards
+		}
+		return eqs
+	}
+
+	// walk returns the counts of the runouts from the board in brd on
+	// street s, and the number of runouts, recording the equities of
+	// every later board.
+	var walk func(s Street, used CardSet) (*equityCounts, int)
+	walk = func(s Street, used CardSet) (*equityCounts, int) {
+		n := s.boardSize()
+		if s == River {
+			for i := range hbs {
+				copy(hbs[i][2:], brd[:])
+				evs[i] = Eval7(&hbs[i])
+			}
+			for i := range riverCounts.shares {
+				riverCounts.shares[i] = 0
+			}
+			riverCounts.add(evs)
+			if opts.Visit != nil {
+				opts.Visit(brd[:], riverCounts.equities(1))
+			}
+			return riverCounts, 1
+		}
+
+		ec := newEquityCounts(H)
+		T := 0
+		// children is the equities of each player on each board on
+		// the next street.
+		var children []float64
+		next := func(used CardSet) {
+			cec, cT := walk(s+1, used)
+			for i := 0; i < H; i++ {
+				children = append(children, cec.equityOf(i, cT))
+			}
+			ec.merge(cec)
+			T += cT
+		}
+		if s == Preflop {
+			for i, a := range deck {
+				for j := i + 1; j < len(deck); j++ {
+					for _, c := range deck[j+1:] {
+						brd[0], brd[1], brd[2] = a, deck[j], c
+						next(used.Add(a).Add(deck[j]).Add(c))
+					}
+				}
+			}
+		} else {
+			for _, c := range deck {
+				if used.Contains(c) {
+					continue
+				}
+				brd[n] = c
+				next(used.Add(c))
+			}
+		}
+
+		eqs := make([]float64, H)
+		for i := range eqs {
+			eqs[i] = ec.equityOf(i, T)
+		}
+		for c := 0; c < len(children); c += H {
+			stats[s+1].add(children[c:c+H], eqs)
+		}
+		if opts.Visit != nil {
+			opts.Visit(brd[:n], equities(ec, T, n))
+		}
+		return ec, T
+	}
+	// The board cards aren't in the deck, so only the cards dealt
+	// from the deck need to be tracked.
+	ec, T := walk(start, 0)
+
+	r := &StreetBreakdown{Equities: equities(ec, T, len(board))}
+	for s := start + 1; s <= River; s++ {
+		r.Streets = append(r.Streets, stats[s].equities(s))
+	}
+	return r, nil
+}
@@ -0,0 +1,116 @@
+package poker
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestHoldemStreetEquities(t *testing.T) {
+	hands := holdemHands(parseHands(t, "CA HK", "DK HT", "H9 D9"))
+	board := parseHands(t, "D2 HQ S7")[0]
+	want, err := HoldemEquities(hands, board)
+	if err != nil {
+		t.Fatal(err)
+	}
+	visits := map[int]int{}
+	var turn []Card
+	var turnEqs []Equity
+	sb, err := HoldemStreetEquities(hands, board, StreetOptions{
+		Visit: func(b []Card, eqs []Equity) {
+			visits[len(b)]++
+			if len(b) == 4 && turn == nil {
+				turn = append([]Card(nil), b...)
+				turnEqs = append([]Equity(nil), eqs...)
+			}
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range want {
+		if !equityClose(sb.Equities[i], want[i]) {
+			t.Errorf("hand %d: got equity %+v, want %+v", i, sb.Equities[i], want[i])
+		}
+	}
+	if wantVisits := map[int]int{3: 1, 4: 43, 5: 43 * 42}; !reflect.DeepEqual(visits, wantVisits) {
+		t.Errorf("visited boards by size %v, want %v", visits, wantVisits)
+	}
+	if eqs, err := HoldemEquities(hands, turn); err != nil || !reflect.DeepEqual(eqs, turnEqs) {
+		t.Errorf("visited turn %v with equities %+v, want %+v (%v)", turn, turnEqs, eqs, err)
+	}
+
+	if len(sb.Streets) != 2 || sb.Streets[0].Street != Turn || sb.Streets[1].Street != River {
+		t.Fatalf("got streets %+v, want turn and river", sb.Streets)
+	}
+	for _, se := range sb.Streets {
+		for i := range hands {
+			if math.Abs(se.Mean[i]-want[i].Equity) > 1e-9 {
+				t.Errorf("%s: hand %d has mean equity %f, want %f", se.Street, i, se.Mean[i], want[i].Equity)
+			}
+			n := 0
+			for _, c := range se.Swings[i] {
+				n += c
+			}
+			if n != se.Boards {
+				t.Errorf("%s: hand %d has %d swings, want %d", se.Street, i, n, se.Boards)
+			}
+			if se.StdDev[i] <= 0 || se.MeanAbsSwing[i] <= 0 {
+				t.Errorf("%s: hand %d has stddev %f and mean swing %f", se.Street, i, se.StdDev[i], se.MeanAbsSwing[i])
+			}
+		}
+	}
+	if b := sb.Streets[1].Boards; b != 43*42 {
+		t.Errorf("got %d river boards, want %d", b, 43*42)
+	}
+
+	if _, err := HoldemStreetEquities(hands, parseHands(t, "D2 HQ")[0], StreetOptions{}); err == nil {
+		t.Errorf("expected error for 2-card board")
+	}
+	if _, err := HoldemStreetEquities(hands, board, StreetOptions{Dead: parseHands(t, "CA")[0]}); err == nil {
+		t.Errorf("expected error for dead card in a hand")
+	}
+}
+
+func TestHoldemStreetEquitiesPreflop(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping preflop street equities in short mode")
+	}
+	hands := holdemHands(parseHands(t, "SA HA", "C7 C6"))
+	want, err := PreflopEquity(hands[0], hands[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	sb, err := HoldemStreetEquities(hands, nil, StreetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range want {
+		if !equityClose(sb.Equities[i], want[i]) {
+			t.Errorf("hand %d: got equity %+v, want %+v", i, sb.Equities[i], want[i])
+		}
+	}
+	for i, want := range []int{17296, 17296 * 45, 17296 * 45 * 44} {
+		if got := sb.Streets[i].Boards; got != want {
+			t.Errorf("%s: got %d boards, want %d", sb.Streets[i].Street, got, want)
+		}
+	}
+}
+
+func TestSwingBucket(t *testing.T) {
+	for _, tc := range []struct {
+		swing float64
+		want  int
+	}{
+		{-1, 0},
+		{-0.95, 0},
+		{-0.05, 9},
+		{0, 10},
+		{0.5, 15},
+		{1, SwingBuckets - 1},
+	} {
+		if got := swingBucket(tc.swing); got != tc.want {
+			t.Errorf("swingBucket(%f) = %d, want %d", tc.swing, got, tc.want)
+		}
+	}
+}
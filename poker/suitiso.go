@@ -0,0 +1,196 @@
+package poker
+
+import "math/bits"
+
+// Many holdem runouts are equivalent given the hands: they have the
+// same ranks, and differ only in the suits of cards that can't make
+// a flush for anyone. This is the same idea as the x-suit in
+// hand64Canonical, which coalesces suits that can't form flushes.
+//
+// A suit can only make a flush for a player if the board has at least
+// 3 cards of that suit, and at most one suit can have that many.
+// So the result of a runout depends only on the ranks of the new
+// cards, the suit with 3 or more cards on the board (if there is one),
+// and which of the new cards are in that suit. isoRunouts enumerates
+// these classes of runouts, evaluating one runout from each class,
+// and weighting it by the number of runouts in the class.
+
+// isoRunouts enumerates the runouts of k cards given the hands and
+// the cards already on the board.
+type isoRunouts struct {
+	k int
+	// avail[r] is the set of suits of cards with rank index r
+	// (the card value divided by 4) that are still in the deck.
+	avail [13]uint8
+	// boardSuits[s] is the number of board cards with suit s.
+	boardSuits [4]int
+}
+
+func newIsoRunouts(deck, board []Card) *isoRunouts {
+	ir := &isoRunouts{k: 5 - len(board)}
+	for _, c := range deck {
+		ir.avail[c>>2] |= 1 << (c & 3)
+	}
+	for _, c := range board {
+		ir.boardSuits[c&3]++
+	}
+	return ir
+}
+
+// rankMultisets returns every multiset of k rank indexes, as
+// non-decreasing slices. These are the units of work for runouts.
+func (ir *isoRunouts) rankMultisets() [][]int {
+	var r [][]int
+	ranks := make([]int, ir.k)
+	var gen func(i, from int)
+	gen = func(i, from int) {
+		if i == ir.k {
+			r = append(r, append([]int(nil), ranks...))
+			return
+		}
+		for x := from; x < 13; x++ {
+			// No more than 4 cards of a rank.
+			if i >= 4 && ranks[i-4] == x {
+				continue
+			}
+			ranks[i] = x
+			gen(i+1, x)
+		}
+	}
+	gen(0, 0)
+	return r
+}
+
+// suitCount returns the number of suits in the set.
+func suitCount(suits uint8) int {
+	return bits.OnesCount8(suits)
+}
+
+// runouts adds to ec the results of all the runouts where the new
+// cards have the given ranks, and returns the number of runouts.
+// The new cards are stored at the start of each of hbs.
+func (ir *isoRunouts) runouts(ranks []int, hbs [][7]Card, evs []int16, ec *equityCounts) int {
+	// The distinct ranks, and how many new cards there are of each.
+	var rs, ms [5]int
+	d := 0
+	for i, r := range ranks {
+		if i > 0 && r == ranks[i-1] {
+			ms[d-1]++
+			continue
+		}
+		rs[d], ms[d] = r, 1
+		d++
+	}
+
+	total := 1
+	for j := 0; j < d; j++ {
+		total *= binomial(suitCount(ir.avail[rs[j]]), ms[j])
+	}
+	if total == 0 {
+		return 0
+	}
+
+	set := func(pos int, c Card) {
+		for i := range hbs {
+			hbs[i][pos] = c
+		}
+	}
+	eval := func(n int) {
+		for i := range hbs {
+			evs[i] = Eval7(&hbs[i])
+		}
+		ec.addN(evs, int64(n))
+	}
+
+	// Runouts where suit s has 3 or more cards on the board, and
+	// the distinct ranks in the set sm are the new cards in suit s.
+	flushes := 0
+	for s := uint8(0); s < 4; s++ {
+		need := 3 - ir.boardSuits[s]
+		for sm := 0; sm < 1<<uint(d); sm++ {
+			if bits.OnesCount(uint(sm)) < need {
+				continue
+			}
+			n := 1
+			for j := 0; j < d; j++ {
+				t := sm >> uint(j) & 1
+				if t == 1 && ir.avail[rs[j]]&(1<<s) == 0 {
+					n = 0
+					break
+				}
+				n *= binomial(suitCount(ir.avail[rs[j]]&^(1<<s)), ms[j]-t)
+			}
+			if n == 0 {
+				continue
+			}
+			pos := 0
+			for j := 0; j < d; j++ {
+				left := ms[j]
+				if sm>>uint(j)&1 == 1 {
+					set(pos, Card(rs[j]*4)+Card(s))
+					pos++
+					left--
+				}
+				for u := uint8(0); u < 4 && left > 0; u++ {
+					if u != s && ir.avail[rs[j]]&(1<<u) != 0 {
+						set(pos, Card(rs[j]*4)+Card(u))
+						pos++
+						left--
+					}
+				}
+			}
+			eval(n)
+			flushes += n
+		}
+	}
+
+	// The remaining runouts have no suit with 3 or more cards on
+	// the board, so nobody can make a flush. Find one of them.
+	if total == flushes {
+		return total
+	}
+	var counts [4]int
+	copy(counts[:], ir.boardSuits[:])
+	var find func(j, pos int) bool
+	find = func(j, pos int) bool {
+		if j == d {
+			return true
+		}
+		for suits := ir.avail[rs[j]]; suits != 0; suits = (suits - 1) & ir.avail[rs[j]] {
+			if suitCount(suits) != ms[j] {
+				continue
+			}
+			ok := true
+			for u := uint8(0); u < 4; u++ {
+				if suits&(1<<u) != 0 && counts[u] >= 2 {
+					ok = false
+				}
+			}
+			if !ok {
+				continue
+			}
+			p := pos
+			for u := uint8(0); u < 4; u++ {
+				if suits&(1<<u) != 0 {
+					counts[u]++
+					set(p, Card(rs[j]*4)+Card(u))
+					p++
+				}
+			}
+			if find(j+1, p) {
+				return true
+			}
+			for u := uint8(0); u < 4; u++ {
+				if suits&(1<<u) != 0 {
+					counts[u]--
+				}
+			}
+		}
+		return false
+	}
+	if !find(0, 0) {
+		panic("no runout found without a flush suit")
+	}
+	eval(total - flushes)
+	return total
+}
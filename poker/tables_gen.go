@@ -8,28 +8,6 @@ var (
 	rootNode3table [16 * 16 * 16]int16
 )
 
-func genTables(ncards int, indextable []uint32, node *tblNode, done []bool) int {
-	table := indextable[node.Index*52 : (node.Index+1)*52]
-	if node.N == ncards-1 {
-		for i, t := range node.T {
-			table[i] = uint32(t.rank)
-		}
-		return 0
-	}
-	S := 0
-	for i, t := range node.T {
-		if t.N == nil {
-			continue
-		}
-		table[i] = (uint32(t.N.Index*52) << 8) | uint32(t.SX.Byte())
-		if !done[t.N.Index] {
-			done[t.N.Index] = true
-			S += genTables(ncards, indextable, t.N, done)
-		}
-	}
-	return 1 + S
-}
-
 // The 3-card tables are simpler: we build a table with the rank for
 // each triple of cards. Hand c1,c2,c3 is stored at index r1*256+r2*16+r3
 // where r1, r2, r3 are the ranks (from 0 to 12) of the cards c1,c2,c3.
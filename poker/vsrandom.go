@@ -0,0 +1,225 @@
+package poker
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// MaxRandomOpponents is the largest number of random opponents that
+// equities can be computed against.
+const MaxRandomOpponents = 9
+
+// EquityVsRandom returns the river equity of a holdem hand against n
+// random hands, given a board of up to 5 cards, where n is between 1
+// and MaxRandomOpponents. Against a single opponent, the equity is
+// exact: every opponent hand and runout is considered, StdErr is zero,
+// and only the dead cards in opts are used. Against 2 or more
+// opponents, the equity is estimated by sampling the opponents' hands
+// and the runout, as configured by opts.
+func EquityVsRandom(hand [2]Card, board []Card, n int, opts SampleOptions) (EquityEstimate, error) {
+	if n < 1 || n > MaxRandomOpponents {
+		return EquityEstimate{}, fmt.Errorf("number of opponents %d must be between 1 and %d", n, MaxRandomOpponents)
+	}
+	deck, err := getRemainingDeck([][2]Card{hand}, board)
+	if err != nil {
+		return EquityEstimate{}, err
+	}
+	k := 5 - len(board)
+	if deck, err = removeDeadCards(deck, opts.Dead, k+2*n); err != nil {
+		return EquityEstimate{}, err
+	}
+	if n == 1 {
+		eq, err := equityVsRandomHand(hand, board, deck, opts.Dead)
+		return EquityEstimate{Equity: eq}, err
+	}
+	if err := opts.check(); err != nil {
+		return EquityEstimate{}, err
+	}
+	rnd := opts.rand()
+
+	// Each of hbs has the hole cards first, then the new board cards,
+	// then the fixed board cards.
+	hbs := make([][7]Card, n+1)
+	for i := range hbs {
+		copy(hbs[i][7-len(board):], board)
+	}
+	hbs[0][0], hbs[0][1] = hand[0], hand[1]
+	ec := newEquityCounts(n + 1)
+	evs := make([]int16, n+1)
+	T := 0
+	for {
+		// Deal the runout and then the opponents' hands by partially
+		// shuffling the deck.
+		for j := 0; j < k+2*n; j++ {
+			r := j + rnd.Intn(len(deck)-j)
+			deck[j], deck[r] = deck[r], deck[j]
+		}
+		for i := range hbs {
+			copy(hbs[i][2:2+k], deck[:k])
+			if i > 0 {
+				hbs[i][0], hbs[i][1] = deck[k+2*i-2], deck[k+2*i-1]
+			}
+		}
+		holdemRiverEquities(hbs, evs, ec)
+		T++
+		if opts.done(ec, T) {
+			break
+		}
+	}
+	return ec.estimates(T)[0], nil
+}
+
+// equityVsRandomHand returns the exact equity of a hand against a
+// random hand from the deck. Each opponent hand has the same number
+// of runouts, so the equities against each can simply be averaged.
+// Preflop with no dead cards, the precomputed heads-up equities are
+// used.
+func equityVsRandomHand(hand [2]Card, board, deck, dead []Card) (Equity, error) {
+	var r Equity
+	n := 0
+	for i, a := range deck {
+		for _, b := range deck[i+1:] {
+			var eq Equity
+			if len(board) == 0 && len(dead) == 0 {
+				eqs, err := PreflopEquity(hand, [2]Card{a, b})
+				if err != nil {
+					return Equity{}, err
+				}
+				eq = eqs[0]
+			} else {
+				eqs, err := HoldemEquitiesWithOptions([][2]Card{hand, {a, b}}, board, EquityOptions{Dead: dead})
+				if err != nil {
+					return Equity{}, err
+				}
+				eq = eqs[0]
+			}
+			r.Equity += eq.Equity
+			r.Win += eq.Win
+			r.Tie += eq.Tie
+			r.Boards += eq.Boards
+			n++
+		}
+	}
+	r.Equity /= float64(n)
+	r.Win /= float64(n)
+	r.Tie /= float64(n)
+	return r, nil
+}
+
+// The preflop equities of each of the 169 classes of starting hands
+// against 1 to MaxRandomOpponents random hands are precomputed by
+// gen_vsrandom.go, and stored in vsRandomData. The classes are
+// indexed as in a 13x13 grid of raw ranks, with pairs on the
+// diagonal, suited hands at row hi and column lo, and offsuit hands
+// at row lo and column hi.
+
+// numStartingHands is the number of classes of holdem starting hands.
+const numStartingHands = 169
+
+// index returns the index of the class, which must be a pair, or
+// suited or offsuit.
+func (rc rangeClass) index() int {
+	if rc.kind == 'o' {
+		return rc.lo*13 + rc.hi
+	}
+	return rc.hi*13 + rc.lo
+}
+
+// InternalStartingHands returns an example hand from each of the
+// classes of holdem starting hands, in the order that they're stored
+// in the precomputed equities against random hands. It's used by
+// gen_vsrandom.go, and is subject to change.
+func InternalStartingHands() [][2]Card {
+	r := make([][2]Card, numStartingHands)
+	for hi := 0; hi < 13; hi++ {
+		for lo := 0; lo <= hi; lo++ {
+			for _, kind := range []byte{'s', 'o'} {
+				rc := rangeClass{hi: hi, lo: lo, kind: kind}
+				if hi == lo {
+					if kind == 'o' {
+						continue
+					}
+					rc.kind = 0
+				}
+				r[rc.index()] = rc.combos()[0]
+			}
+		}
+	}
+	return r
+}
+
+var (
+	vsRandomTbl     []float64
+	vsRandomTblInit sync.Once
+)
+
+// vsRandomEquities returns the table of preflop equities against
+// random hands, decoding it on first use.
+func vsRandomEquities() []float64 {
+	vsRandomTblInit.Do(func() {
+		t, err := decodeVsRandomData(vsRandomData)
+		if err != nil {
+			panic(err)
+		}
+		vsRandomTbl = t
+	})
+	return vsRandomTbl
+}
+
+// vsRandomScale is the scale that equities are stored at: they're
+// stored in millionths.
+const vsRandomScale = 1e6
+
+// decodeVsRandomData decodes data written by gen_vsrandom.go. It's
+// base64-encoded gzipped data: for each class of starting hand in
+// index order, its equities against 1 to MaxRandomOpponents random
+// hands, as uvarints in units of 1/vsRandomScale.
+func decodeVsRandomData(data string) ([]float64, error) {
+	zr, err := gzip.NewReader(base64.NewDecoder(base64.RawStdEncoding, strings.NewReader(data)))
+	if err != nil {
+		return nil, err
+	}
+	r := bufio.NewReader(zr)
+	t := make([]float64, numStartingHands*MaxRandomOpponents)
+	for i := range t {
+		v, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read equity %d against random hands: %v", i, err)
+		}
+		t[i] = float64(v) / vsRandomScale
+	}
+	return t, zr.Close()
+}
+
+// PreflopEquityVsRandom returns the preflop equity of a class of
+// holdem starting hands, like "AKs" or "77", against n random hands,
+// where n is between 1 and MaxRandomOpponents, from precomputed data.
+// A class like "AK" with both suited and offsuit hands gives the
+// average over its combos. The heads-up equities are exact, and the
+// equities against 2 or more opponents were estimated by sampling,
+// with a standard error of about 0.001.
+func PreflopEquityVsRandom(class string, n int) (float64, error) {
+	if n < 1 || n > MaxRandomOpponents {
+		return 0, fmt.Errorf("number of opponents %d must be between 1 and %d", n, MaxRandomOpponents)
+	}
+	rc, err := parseRangeClass(class)
+	if err != nil {
+		return 0, err
+	}
+	t := vsRandomEquities()
+	eq := func(rc rangeClass) float64 {
+		return t[rc.index()*MaxRandomOpponents+n-1]
+	}
+	if rc.kind == 0 && rc.hi != rc.lo {
+		// There are 4 suited combos and 12 offsuit ones.
+		s, o := rc, rc
+		s.kind, o.kind = 's', 'o'
+		return (eq(s) + 3*eq(o)) / 4, nil
+	}
+	return eq(rc), nil
+}
@@ -0,0 +1,130 @@
+package poker
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestEquityVsRandomHeadsUp(t *testing.T) {
+	hand := holdemHands(parseHands(t, "SA HK"))[0]
+	board := parseHands(t, "S2 HQ D7 CJ")[0]
+	got, err := EquityVsRandom(hand, board, 1, SampleOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Compare against the equity of the hand against a range of every
+	// possible hand.
+	any := FullRange()
+	want, err := RangeEquities([]Range{HandRange(hand), any}, board)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equityClose(got.Equity, want[0]) || got.StdErr != 0 {
+		t.Errorf("EquityVsRandom(%v, %v, 1) = %+v, want %+v", hand, board, got, want[0])
+	}
+
+	// On the river, a dead card just removes the opponent hands
+	// that contain it.
+	river := append(append([]Card(nil), board...), NameToCard["C3"])
+	dead := NameToCard["SQ"]
+	got, err = EquityVsRandom(hand, river, 1, SampleOptions{Dead: []Card{dead}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var live Range
+	for _, c := range any {
+		if c.Cards[0] != dead && c.Cards[1] != dead {
+			live = append(live, c)
+		}
+	}
+	want, err = RangeEquities([]Range{HandRange(hand), live}, river)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !equityClose(got.Equity, want[0]) {
+		t.Errorf("EquityVsRandom(%v, %v, 1) with %s dead = %+v, want %+v", hand, river, dead, got, want[0])
+	}
+}
+
+func TestEquityVsRandom(t *testing.T) {
+	for _, tc := range []struct {
+		hand  string
+		n     int
+		class string
+	}{
+		{"SA HA", 1, "AA"},
+		{"S7 H2", 1, "72o"},
+		{"SA HA", 2, "AA"},
+		{"DT D9", 4, "T9s"},
+		{"C5 H5", 9, "55"},
+	} {
+		hand := holdemHands(parseHands(t, tc.hand))[0]
+		got, err := EquityVsRandom(hand, nil, tc.n, SampleOptions{Samples: 20000, Rand: rand.New(rand.NewSource(1))})
+		if err != nil {
+			t.Fatalf("EquityVsRandom(%v, %d) failed: %v", hand, tc.n, err)
+		}
+		want, err := PreflopEquityVsRandom(tc.class, tc.n)
+		if err != nil {
+			t.Fatalf("PreflopEquityVsRandom(%s, %d) failed: %v", tc.class, tc.n, err)
+		}
+		// The precomputed multiway equities have a standard error of
+		// about 0.001.
+		tol := 1e-6
+		if tc.n > 1 {
+			tol = 4 * math.Hypot(got.StdErr, 0.001)
+		}
+		if math.Abs(got.Equity.Equity-want) > tol {
+			t.Errorf("EquityVsRandom(%v, %d) = %f (±%f), PreflopEquityVsRandom(%s, %d) = %f", hand, tc.n, got.Equity.Equity, got.StdErr, tc.class, tc.n, want)
+		}
+	}
+}
+
+func TestPreflopEquityVsRandom(t *testing.T) {
+	for _, tc := range []struct {
+		class    string
+		n        int
+		min, max float64
+	}{
+		{"AA", 1, 0.852, 0.853},
+		{"72o", 1, 0.345, 0.347},
+		{"AKs", 1, 0.670, 0.671},
+		{"AA", 9, 0.30, 0.32},
+	} {
+		got, err := PreflopEquityVsRandom(tc.class, tc.n)
+		if err != nil {
+			t.Fatalf("PreflopEquityVsRandom(%s, %d) failed: %v", tc.class, tc.n, err)
+		}
+		if got < tc.min || got > tc.max {
+			t.Errorf("PreflopEquityVsRandom(%s, %d) = %f, want between %f and %f", tc.class, tc.n, got, tc.min, tc.max)
+		}
+	}
+
+	// AK is a quarter AKs, and three quarters AKo.
+	s, _ := PreflopEquityVsRandom("AKs", 3)
+	o, _ := PreflopEquityVsRandom("AKo", 3)
+	if ak, _ := PreflopEquityVsRandom("AK", 3); math.Abs(ak-(s+3*o)/4) > 1e-9 {
+		t.Errorf("PreflopEquityVsRandom(AK, 3) = %f, want %f", ak, (s+3*o)/4)
+	}
+	// Equity falls as the number of opponents rises.
+	prev := 1.0
+	for n := 1; n <= MaxRandomOpponents; n++ {
+		e, err := PreflopEquityVsRandom("QJs", n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e >= prev {
+			t.Errorf("PreflopEquityVsRandom(QJs, %d) = %f, not less than %f", n, e, prev)
+		}
+		prev = e
+	}
+
+	for _, n := range []int{0, MaxRandomOpponents + 1} {
+		if _, err := PreflopEquityVsRandom("AA", n); err == nil {
+			t.Errorf("expected error for %d opponents", n)
+		}
+	}
+	if _, err := PreflopEquityVsRandom("AAs", 1); err == nil {
+		t.Errorf("expected error for bad class")
+	}
+}